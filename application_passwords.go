@@ -0,0 +1,101 @@
+package wordpress
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ApplicationPassword is a credential of a user for API clients, used as
+// the Password of Options with the user's Username.
+type ApplicationPassword struct {
+	UUID string `json:"uuid,omitempty"`
+	// AppID is an optional UUID identifying the application.
+	AppID string `json:"app_id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	// Password is the plaintext password. It is only returned by Create, and
+	// cannot be retrieved afterwards.
+	Password string `json:"password,omitempty"`
+	Created  string `json:"created,omitempty"`
+	LastUsed string `json:"last_used,omitempty"`
+	LastIP   string `json:"last_ip,omitempty"`
+}
+
+// ApplicationPasswordsCollection is the
+// `/users/[user_id]/application-passwords` collection.
+type ApplicationPasswordsCollection struct {
+	client *Client
+	url    string
+	parent interface{}
+}
+
+func (col *ApplicationPasswordsCollection) List(params interface{}) ([]ApplicationPassword, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *ApplicationPasswordsCollection) ListContext(ctx context.Context, params interface{}) ([]ApplicationPassword, *http.Response, []byte, error) {
+	var passwords []ApplicationPassword
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &passwords)
+	return passwords, resp, body, err
+}
+
+// Create creates an application password named new.Name. The returned one
+// holds the plaintext password, which is only returned once.
+func (col *ApplicationPasswordsCollection) Create(new *ApplicationPassword) (*ApplicationPassword, *http.Response, []byte, error) {
+	return col.CreateContext(context.Background(), new)
+}
+func (col *ApplicationPasswordsCollection) CreateContext(ctx context.Context, new *ApplicationPassword) (*ApplicationPassword, *http.Response, []byte, error) {
+	var created ApplicationPassword
+	content := map[string]string{"name": new.Name}
+	if new.AppID != "" {
+		content["app_id"] = new.AppID
+	}
+	resp, body, err := col.client.CreateContext(ctx, col.url, content, &created)
+	return &created, resp, body, err
+}
+
+func (col *ApplicationPasswordsCollection) Get(uuid string, params interface{}) (*ApplicationPassword, *http.Response, []byte, error) {
+	return col.GetContext(context.Background(), uuid, params)
+}
+func (col *ApplicationPasswordsCollection) GetContext(ctx context.Context, uuid string, params interface{}) (*ApplicationPassword, *http.Response, []byte, error) {
+	var password ApplicationPassword
+	entityURL := fmt.Sprintf("%v/%v", col.url, uuid)
+	resp, body, err := col.client.GetContext(ctx, entityURL, params, &password)
+	return &password, resp, body, err
+}
+
+// Introspect returns the application password authenticating the current
+// request. It fails unless the client authenticates with an application
+// password of this user.
+func (col *ApplicationPasswordsCollection) Introspect() (*ApplicationPassword, *http.Response, []byte, error) {
+	return col.IntrospectContext(context.Background())
+}
+func (col *ApplicationPasswordsCollection) IntrospectContext(ctx context.Context) (*ApplicationPassword, *http.Response, []byte, error) {
+	var password ApplicationPassword
+	resp, body, err := col.client.GetContext(ctx, col.url+"/introspect", nil, &password)
+	return &password, resp, body, err
+}
+
+// Delete revokes the application password uuid and returns it.
+func (col *ApplicationPasswordsCollection) Delete(uuid string) (*ApplicationPassword, *http.Response, []byte, error) {
+	return col.DeleteContext(context.Background(), uuid)
+}
+func (col *ApplicationPasswordsCollection) DeleteContext(ctx context.Context, uuid string) (*ApplicationPassword, *http.Response, []byte, error) {
+	var deleted ApplicationPassword
+	entityURL := fmt.Sprintf("%v/%v", col.url, uuid)
+	resp, body, err := col.client.DeleteContext(ctx, entityURL, nil, &deletedEntity[ApplicationPassword]{entity: &deleted})
+	return &deleted, resp, body, err
+}
+
+// DeleteAll revokes all application passwords of the user and returns how
+// many were revoked.
+func (col *ApplicationPasswordsCollection) DeleteAll() (int, *http.Response, []byte, error) {
+	return col.DeleteAllContext(context.Background())
+}
+func (col *ApplicationPasswordsCollection) DeleteAllContext(ctx context.Context) (int, *http.Response, []byte, error) {
+	var response struct {
+		Deleted bool `json:"deleted"`
+		Count   int  `json:"count"`
+	}
+	resp, body, err := col.client.DeleteContext(ctx, col.url, nil, &response)
+	return response.Count, resp, body, err
+}
@@ -0,0 +1,88 @@
+package wordpress_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestApplicationPasswords(t *testing.T) {
+	const uuid = "8f1f8a5e-4a8b-4d53-9a1e-2f0c5d6a7b8c"
+	wp := initTestRoutesClient(t, map[string]http.HandlerFunc{
+		"GET /users/me": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"id":3,"name":"integrations"}`))
+		},
+		"GET /users/3/application-passwords": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`[{"uuid":"` + uuid + `","app_id":"","name":"CI","created":"2024-06-01T10:00:00","last_used":null,"last_ip":null}]`))
+		},
+		"POST /users/3/application-passwords": func(w http.ResponseWriter, r *http.Request) {
+			var sent map[string]string
+			json.NewDecoder(r.Body).Decode(&sent)
+			if len(sent) != 1 || sent["name"] != "Sync" {
+				t.Errorf("Unexpected request: %v", sent)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"uuid":"` + uuid + `","name":"Sync","password":"abcd efgh ijkl mnop qrst uvwx"}`))
+		},
+		"GET /users/3/application-passwords/" + uuid: func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"uuid":"` + uuid + `","name":"Sync"}`))
+		},
+		"GET /users/3/application-passwords/introspect": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"uuid":"` + uuid + `","name":"Sync","last_ip":"127.0.0.1"}`))
+		},
+		"DELETE /users/3/application-passwords/" + uuid: func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"deleted":true,"previous":{"uuid":"` + uuid + `","name":"Sync"}}`))
+		},
+		"DELETE /users/3/application-passwords": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"deleted":true,"count":2}`))
+		},
+	})
+
+	me, _, _, err := wp.Users().Me(nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	passwords := me.ApplicationPasswords()
+	if passwords == nil {
+		t.Fatalf("User returned by Me should have application passwords")
+	}
+
+	list, _, _, err := passwords.List(nil)
+	if err != nil || len(list) != 1 || list[0].Name != "CI" || list[0].LastUsed != "" {
+		t.Errorf("Unexpected list: %+v, %v", list, err)
+	}
+
+	created, _, _, err := passwords.Create(&wordpress.ApplicationPassword{Name: "Sync"})
+	if err != nil || created.Password != "abcd efgh ijkl mnop qrst uvwx" {
+		t.Errorf("Created password should hold the plaintext password: %+v, %v", created, err)
+	}
+
+	got, _, _, err := passwords.Get(uuid, nil)
+	if err != nil || got.Name != "Sync" || got.Password != "" {
+		t.Errorf("Unexpected password: %+v, %v", got, err)
+	}
+
+	current, _, _, err := passwords.Introspect()
+	if err != nil || current.LastIP != "127.0.0.1" {
+		t.Errorf("Unexpected introspection: %+v, %v", current, err)
+	}
+
+	deleted, _, _, err := passwords.Delete(uuid)
+	if err != nil || deleted.UUID != uuid {
+		t.Errorf("Unexpected deleted password: %+v, %v", deleted, err)
+	}
+
+	count, _, _, err := passwords.DeleteAll()
+	if err != nil || count != 2 {
+		t.Errorf("Unexpected revoked count: %v, %v", count, err)
+	}
+}
+
+func TestApplicationPasswords_WithoutCollection(t *testing.T) {
+	user := wordpress.User{ID: 3}
+	if user.ApplicationPasswords() != nil {
+		t.Errorf("User without collection should have no application passwords")
+	}
+}
@@ -0,0 +1,47 @@
+package wordpress
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// AutosavesCollection is the `/[parent_base]/[parent_id]/autosaves`
+// collection. Autosaves are revisions of their parent holding unsaved editor
+// work.
+type AutosavesCollection struct {
+	client     *Client
+	url        string
+	parent     interface{}
+	parentType string
+}
+
+func (col *AutosavesCollection) List(params interface{}) ([]Revision, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *AutosavesCollection) ListContext(ctx context.Context, params interface{}) ([]Revision, *http.Response, []byte, error) {
+	var autosaves []Revision
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &autosaves)
+	return autosaves, resp, body, err
+}
+
+func (col *AutosavesCollection) Get(id int, params interface{}) (*Revision, *http.Response, []byte, error) {
+	return col.GetContext(context.Background(), id, params)
+}
+func (col *AutosavesCollection) GetContext(ctx context.Context, id int, params interface{}) (*Revision, *http.Response, []byte, error) {
+	var autosave Revision
+	entityURL := fmt.Sprintf("%v/%v", col.url, id)
+	resp, body, err := col.client.GetContext(ctx, entityURL, params, &autosave)
+	return &autosave, resp, body, err
+}
+
+// Create autosaves the title, content and excerpt of new. Wordpress updates
+// the parent itself instead when it is a draft owned by the current user.
+func (col *AutosavesCollection) Create(new *Revision) (*Revision, *http.Response, []byte, error) {
+	return col.CreateContext(context.Background(), new)
+}
+func (col *AutosavesCollection) CreateContext(ctx context.Context, new *Revision) (*Revision, *http.Response, []byte, error) {
+	var created Revision
+	resp, body, err := col.client.CreateContext(ctx, col.url, new, &created)
+	return &created, resp, body, err
+}
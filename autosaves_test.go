@@ -0,0 +1,53 @@
+package wordpress_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestAutosaves_PostAndPage(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /posts/5/autosaves":
+			w.Write([]byte(`[{"id":11,"parent":5,"author":1,"modified":"2024-06-04T09:00:00","content":{"raw":"unsaved"}}]`))
+		case "GET /posts/5/autosaves/11":
+			w.Write([]byte(`{"id":11,"parent":5}`))
+		case "POST /pages/3/autosaves":
+			var sent wordpress.Revision
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.Write([]byte(`{"id":12,"parent":3,"title":{"raw":"` + sent.Title.Raw + `"}}`))
+		default:
+			t.Errorf("Unexpected request: %v %v", r.Method, r.URL.Path)
+		}
+	})
+
+	autosaves, _, _, err := wp.Posts().Entity(5).Autosaves().List(nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if len(autosaves) != 1 || autosaves[0].Content.Raw != "unsaved" {
+		t.Errorf("Unexpected autosaves: %+v", autosaves)
+	}
+	autosave, _, _, err := wp.Posts().Entity(5).Autosaves().Get(11, nil)
+	if err != nil || autosave.Parent != 5 {
+		t.Errorf("Unexpected autosave: %+v, %v", autosave, err)
+	}
+
+	created, _, _, err := wp.Pages().Entity(3).Autosaves().Create(&wordpress.Revision{Title: wordpress.RenderedText{Raw: "Draft title"}})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if created.ID != 12 || created.Title.Raw != "Draft title" {
+		t.Errorf("Unexpected autosave: %+v", created)
+	}
+}
+
+func TestAutosaves_WithoutCollection(t *testing.T) {
+	post := wordpress.Post{ID: 5}
+	if post.Autosaves() != nil {
+		t.Errorf("Post without collection should have no autosaves")
+	}
+}
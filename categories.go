@@ -0,0 +1,7 @@
+package wordpress
+
+// CategoriesCollection is the `/categories` collection. Categories are
+// hierarchical terms of the `category` taxonomy.
+type CategoriesCollection struct {
+	*TaxonomyTermsCollection
+}
@@ -0,0 +1,97 @@
+package wordpress_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func initTestCategoriesClient(t *testing.T) *wordpress.Client {
+	return initTestRoutesClient(t, map[string]http.HandlerFunc{
+		"GET /categories": func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			if query.Get("parent") == "0" && query.Get("hide_empty") == "true" {
+				w.Write([]byte(`[{"id":1,"count":12,"name":"News","slug":"news","taxonomy":"category","parent":0,"meta":[]}]`))
+				return
+			}
+			if query.Get("parent") == "1" {
+				w.Write([]byte(`[{"id":5,"count":3,"name":"Local","slug":"local","taxonomy":"category","parent":1,"meta":{"color":"red"}}]`))
+				return
+			}
+			t.Errorf("Unexpected query: %v", r.URL.RawQuery)
+		},
+		"POST /categories": func(w http.ResponseWriter, r *http.Request) {
+			var term wordpress.Term
+			json.NewDecoder(r.Body).Decode(&term)
+			term.ID = 6
+			term.Taxonomy = "category"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(term)
+		},
+		"DELETE /categories/6": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("force") != "true" {
+				w.WriteHeader(http.StatusNotImplemented)
+				w.Write([]byte(`{"code":"rest_trash_not_supported","message":"Terms do not support trashing. Set 'force=true' to delete.","data":{"status":501}}`))
+				return
+			}
+			w.Write([]byte(`{"deleted":true,"previous":{"id":6,"count":0,"name":"Regional","taxonomy":"category","parent":1}}`))
+		},
+	})
+}
+
+func TestCategoriesChildren(t *testing.T) {
+	wp := initTestCategoriesClient(t)
+
+	hideEmpty := true
+	top, _, _, err := wp.Categories().Children(0, &wordpress.TermListParams{HideEmpty: &hideEmpty})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if len(top) != 1 || top[0].Count != 12 {
+		t.Errorf("Unexpected categories: %+v", top)
+	}
+
+	var children []wordpress.Term
+	for term, err := range wp.Categories().AllChildren(context.Background(), top[0].ID, nil) {
+		if err != nil {
+			t.Fatalf("Should not return error: %v", err.Error())
+		}
+		children = append(children, term)
+	}
+	if len(children) != 1 || children[0].Parent != 1 || children[0].Meta["color"] != "red" {
+		t.Errorf("Unexpected children: %+v", children)
+	}
+}
+
+func TestCategoriesCreateDelete(t *testing.T) {
+	wp := initTestCategoriesClient(t)
+
+	created, resp, _, err := wp.Categories().Create(&wordpress.Term{Name: "Regional", Parent: 1})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if resp.StatusCode != http.StatusCreated || created.ID != 6 || created.Parent != 1 {
+		t.Errorf("Unexpected created category: %+v", created)
+	}
+
+	deleted, _, _, err := wp.Categories().Delete(created.ID)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if deleted.ID != created.ID || deleted.Name != "Regional" {
+		t.Errorf("Deleted category should be decoded from `previous`: %+v", deleted)
+	}
+}
+
+func TestTermCountDecoding(t *testing.T) {
+	var term wordpress.Term
+	if err := json.Unmarshal([]byte(`{"id":1,"count":42,"name":"News"}`), &term); err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if term.Count != 42 {
+		t.Errorf("Expected count 42, got %v", term.Count)
+	}
+}
@@ -2,13 +2,17 @@ package wordpress
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"net/url"
 	"reflect"
+	"time"
 )
 
 const (
@@ -23,17 +27,35 @@ const (
 	CollectionTerms      = "terms"
 	CollectionStatuses   = "statuses"
 	CollectionTypes      = "types"
+	CollectionAutosaves  = "autosaves"
+	CollectionCategories = "categories"
+	CollectionTags       = "tags"
+	CollectionSearch     = "search"
+	CollectionSettings   = "settings"
+	CollectionPlugins    = "plugins"
+	CollectionThemes     = "themes"
+	CollectionMenus      = "menus"
+
+	CollectionMenuItems     = "menu-items"
+	CollectionMenuLocations = "menu-locations"
+
+	CollectionApplicationPasswords = "application-passwords"
 )
 
 type GeneralError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Data    int    `json:"data"` // Unsure if this is consistent
+	Code    string           `json:"code"`
+	Message string           `json:"message"`
+	Data    GeneralErrorData `json:"data"`
 }
 
 type Options struct {
 	BaseAPIURL string
-	Debug      bool
+
+	// Logger receives one event per request. Defaults to slog.Default().
+	Logger *slog.Logger
+	// Debug logs requests at debug level, including headers and bodies.
+	// Credentials, application passwords, JWTs and emails are redacted.
+	Debug bool
 
 	// Basic Auth (WP Application Passwords).
 	Username string
@@ -41,6 +63,34 @@ type Options struct {
 
 	// JWT Bearer token (jwt-auth plugin). When set, takes precedence over Basic Auth.
 	JwtToken string
+
+	// Retry policy for failed requests. Nil disables retries.
+	Retry *RetryPolicy
+
+	// Middlewares applied to every request, in order. See Client.Use.
+	Middlewares []Middleware
+
+	// HTTPClient, if set, is used instead of the default client. Its
+	// CheckRedirect, if any, is called after auth is re-applied.
+	HTTPClient *http.Client
+	// Transport, if set, replaces the transport of the default client or of
+	// HTTPClient.
+	Transport http.RoundTripper
+
+	// Settings of the default transport, ignored when Transport is set or
+	// HTTPClient has its own. Zero values use the Default* constants.
+	DialTimeout           time.Duration
+	TLSHandshakeTimeout   time.Duration
+	ResponseHeaderTimeout time.Duration
+	IdleConnTimeout       time.Duration
+	MaxIdleConnsPerHost   int
+	// Proxy defaults to http.ProxyFromEnvironment.
+	Proxy func(*http.Request) (*url.URL, error)
+	// TLSConfig is cloned; RootCAs and Certificates, if set, override the
+	// corresponding fields for custom CAs and client certificates.
+	TLSConfig    *tls.Config
+	RootCAs      *x509.CertPool
+	Certificates []tls.Certificate
 }
 
 // applyAuth sets the Authorization header on req using whichever credential
@@ -56,75 +106,145 @@ func (o *Options) applyAuth(req *http.Request) {
 }
 
 type Client struct {
-	httpClient *http.Client
-	options    *Options
-	baseURL    string
+	httpClient  *http.Client
+	options     *Options
+	baseURL     string
+	middlewares []Middleware
+	handler     Handler
+	logger      *slog.Logger
 }
 
-// Used to create a new http.Client object.
+const (
+	DefaultDialTimeout           = 30 * time.Second
+	DefaultKeepAlive             = 30 * time.Second
+	DefaultTLSHandshakeTimeout   = 10 * time.Second
+	DefaultResponseHeaderTimeout = 60 * time.Second
+	DefaultIdleConnTimeout       = 90 * time.Second
+	DefaultMaxIdleConnsPerHost   = 10
+)
+
+// Used to create the default http.Transport, with keep-alive connection
+// pooling, timeouts, proxy-from-environment and the configured TLS settings.
+func newTransport(options *Options) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   durationOr(options.DialTimeout, DefaultDialTimeout),
+		KeepAlive: DefaultKeepAlive,
+	}
+	proxy := options.Proxy
+	if proxy == nil {
+		proxy = http.ProxyFromEnvironment
+	}
+	maxIdleConnsPerHost := options.MaxIdleConnsPerHost
+	if maxIdleConnsPerHost <= 0 {
+		maxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+
+	var tlsConfig *tls.Config
+	if options.TLSConfig != nil {
+		tlsConfig = options.TLSConfig.Clone()
+	}
+	if options.RootCAs != nil || len(options.Certificates) > 0 {
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		if options.RootCAs != nil {
+			tlsConfig.RootCAs = options.RootCAs
+		}
+		if len(options.Certificates) > 0 {
+			tlsConfig.Certificates = options.Certificates
+		}
+	}
+
+	return &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   durationOr(options.TLSHandshakeTimeout, DefaultTLSHandshakeTimeout),
+		ResponseHeaderTimeout: durationOr(options.ResponseHeaderTimeout, DefaultResponseHeaderTimeout),
+		IdleConnTimeout:       durationOr(options.IdleConnTimeout, DefaultIdleConnTimeout),
+		ExpectContinueTimeout: time.Second,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+		ForceAttemptHTTP2:     true,
+	}
+}
+
+func durationOr(d time.Duration, fallback time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return fallback
+}
+
+// Used to create a new http.Client object. An injected Options.HTTPClient is
+// copied, not modified; Options.Transport replaces the default transport.
+// In both cases auth is re-applied on redirects.
 func newHTTPClient(options *Options) *http.Client {
-	transport := &http.Transport{
-		DisableKeepAlives: true,
-		// TODO: Add other transport configurations if needed, e.g., TLS, Proxy
-	}
-	client := &http.Client{
-		Transport: transport,
-		Jar:       nil, // Explicitly nil, as gorequest did
-		CheckRedirect: func(req *http.Request, via []*http.Request) error {
-			// Re-apply auth on redirect (Go drops Authorization across hosts).
-			options.applyAuth(req)
-			if options.Debug {
-				log.Printf("REDIRECT: Request to %s via %d hops", req.URL, len(via))
-			}
-			// Default policy: allow up to 10 redirects.
-			if len(via) >= 10 {
-				return http.ErrUseLastResponse
-			}
-			return nil
-		},
+	var client *http.Client
+	if options.HTTPClient != nil {
+		copied := *options.HTTPClient
+		client = &copied
+	} else {
+		client = &http.Client{
+			Jar: nil, // Explicitly nil, as gorequest did
+		}
+	}
+	if options.Transport != nil {
+		client.Transport = options.Transport
+	} else if client.Transport == nil {
+		client.Transport = newTransport(options)
+	}
+
+	checkRedirect := client.CheckRedirect
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		// Re-apply auth on redirect (Go drops Authorization across hosts).
+		options.applyAuth(req)
+		options.logger().LogAttrs(req.Context(), slog.LevelDebug, "wordpress redirect",
+			slog.String("url", redactURL(req.URL)),
+			slog.Int("hops", len(via)),
+		)
+		if checkRedirect != nil {
+			return checkRedirect(req, via)
+		}
+		// Default policy: allow up to 10 redirects.
+		if len(via) >= 10 {
+			return http.ErrUseLastResponse
+		}
+		return nil
 	}
 	return client
 }
 
 func NewClient(options *Options) *Client {
 	httpClient := newHTTPClient(options)
-	// Basic auth will be set per request, or handled by CheckRedirect for subsequent requests.
-	// Debug logging for requests will need to be handled manually if required, outside of client setup.
-	return &Client{
-		httpClient: httpClient,
-		options:    options,
-		baseURL:    options.BaseAPIURL,
-	}
+	// Auth and logging are applied per request by the middleware chain,
+	// and by CheckRedirect for subsequent requests.
+	client := &Client{
+		httpClient:  httpClient,
+		options:     options,
+		baseURL:     options.BaseAPIURL,
+		middlewares: append([]Middleware(nil), options.Middlewares...),
+		logger:      options.logger(),
+	}
+	client.handler = client.buildHandler()
+	return client
 }
 
 func (client *Client) Users() *UsersCollection {
-	return &UsersCollection{
-		client: client,
-		url:    fmt.Sprintf("%v/%v", client.baseURL, CollectionUsers),
-	}
+	return newUsersCollection(client, fmt.Sprintf("%v/%v", client.baseURL, CollectionUsers))
 }
 func (client *Client) Posts() *PostsCollection {
-	return &PostsCollection{
-		client: client,
-		url:    fmt.Sprintf("%v/%v", client.baseURL, CollectionPosts),
-	}
+	return newPostsCollection(client, fmt.Sprintf("%v/%v", client.baseURL, CollectionPosts))
 }
 func (client *Client) Pages() *PagesCollection {
-	return &PagesCollection{
-		client: client,
-		url:    fmt.Sprintf("%v/%v", client.baseURL, CollectionPages),
-	}
+	return newPagesCollection(client, fmt.Sprintf("%v/%v", client.baseURL, CollectionPages))
 }
 func (client *Client) Media() *MediaCollection {
-	return &MediaCollection{
-		client: client,
-		url:    fmt.Sprintf("%v/%v", client.baseURL, CollectionMedia),
-	}
+	return newMediaCollection(client, fmt.Sprintf("%v/%v", client.baseURL, CollectionMedia))
 }
 func (client *Client) Comments() *CommentsCollection {
 	return &CommentsCollection{
-		client: client,
-		url:    fmt.Sprintf("%v/%v", client.baseURL, CollectionComments),
+		Collection: newCollection[Comment](client, fmt.Sprintf("%v/%v", client.baseURL, CollectionComments), nil),
 	}
 }
 func (client *Client) Taxonomies() *TaxonomiesCollection {
@@ -139,6 +259,16 @@ func (client *Client) Terms() *TermsCollection {
 		url:    fmt.Sprintf("%v/%v", client.baseURL, CollectionTerms),
 	}
 }
+func (client *Client) Categories() *CategoriesCollection {
+	return &CategoriesCollection{
+		TaxonomyTermsCollection: newTaxonomyTermsCollection(client, fmt.Sprintf("%v/%v", client.baseURL, CollectionCategories), nil),
+	}
+}
+func (client *Client) Tags() *TagsCollection {
+	return &TagsCollection{
+		TaxonomyTermsCollection: newTaxonomyTermsCollection(client, fmt.Sprintf("%v/%v", client.baseURL, CollectionTags), nil),
+	}
+}
 func (client *Client) Statuses() *StatusesCollection {
 	return &StatusesCollection{
 		client: client,
@@ -151,177 +281,110 @@ func (client *Client) Types() *TypesCollection {
 		url:    fmt.Sprintf("%v/%v", client.baseURL, CollectionTypes),
 	}
 }
-
-func (client *Client) List(url_ string, params interface{}, result interface{}) (*http.Response, []byte, error) {
-	req, err := http.NewRequest("GET", url_, nil)
-	if err != nil {
-		return nil, nil, err
+func (client *Client) Search() *SearchCollection {
+	return &SearchCollection{
+		client: client,
+		url:    fmt.Sprintf("%v/%v", client.baseURL, CollectionSearch),
 	}
-
-	if params != nil {
-		query := req.URL.Query()
-		// Assuming params is a struct or map[string]...
-		// This part needs a robust way to convert params to query string
-		// For simplicity, let's assume params is map[string]string for now
-		// A more generic solution would use reflection or a library
-		if pMap, ok := params.(map[string]string); ok {
-			for k, v := range pMap {
-				query.Add(k, v)
-			}
-		} else if pVal, ok := params.(url.Values); ok {
-			req.URL.RawQuery = pVal.Encode()
-		} else {
-			// Fallback or error if params type is not handled
-			// For now, attempting to marshal and unmarshal to url.Values
-			// This is inefficient and might not cover all cases.
-			// Consider using a library like github.com/google/go-querystring
-			jsonParams, _ := json.Marshal(params)
-			var mapParams map[string]interface{}
-			_ = json.Unmarshal(jsonParams, &mapParams)
-			q := url.Values{}
-			for k, v := range mapParams {
-				q.Add(k, fmt.Sprintf("%v", v))
-			}
-			req.URL.RawQuery = q.Encode()
-		}
-		if req.URL.RawQuery == "" && params != nil { // if not already set by url.Values
-			// Simplified: convert params to query string (needs improvement for complex types)
-			// This is a placeholder for a more robust query parameter encoding
-			q := req.URL.Query()
-			jsonParams, _ := json.Marshal(params)
-			var mapParams map[string]interface{}
-			_ = json.Unmarshal(jsonParams, &mapParams)
-			for k, v := range mapParams {
-				// Handle slices and other types appropriately
-				switch val := v.(type) {
-				case []interface{}:
-					for _, item := range val {
-						q.Add(k, fmt.Sprintf("%v", item))
-					}
-				default:
-					q.Add(k, fmt.Sprintf("%v", val))
-				}
-			}
-			req.URL.RawQuery = q.Encode()
-		}
+}
+func (client *Client) Settings() *SettingsCollection {
+	return &SettingsCollection{
+		client: client,
+		url:    fmt.Sprintf("%v/%v", client.baseURL, CollectionSettings),
 	}
-
-	req.Header.Set("Accept", "application/json")
-	client.options.applyAuth(req)
-
-	if client.options.Debug {
-		log.Printf("Request: GET %s, Params: %+v\\n", url_, params)
+}
+func (client *Client) Plugins() *PluginsCollection {
+	return &PluginsCollection{
+		client: client,
+		url:    fmt.Sprintf("%v/%v", client.baseURL, CollectionPlugins),
+	}
+}
+func (client *Client) Themes() *ThemesCollection {
+	return &ThemesCollection{
+		client: client,
+		url:    fmt.Sprintf("%v/%v", client.baseURL, CollectionThemes),
+	}
+}
+func (client *Client) Menus() *MenusCollection {
+	return &MenusCollection{
+		Collection: newCollection[Menu](client, fmt.Sprintf("%v/%v", client.baseURL, CollectionMenus), nil),
+	}
+}
+func (client *Client) MenuItems() *MenuItemsCollection {
+	return &MenuItemsCollection{
+		Collection: newCollection[MenuItem](client, fmt.Sprintf("%v/%v", client.baseURL, CollectionMenuItems), nil),
+	}
+}
+func (client *Client) MenuLocations() *MenuLocationsCollection {
+	return &MenuLocationsCollection{
+		client: client,
+		url:    fmt.Sprintf("%v/%v", client.baseURL, CollectionMenuLocations),
 	}
+}
 
-	resp, err := client.httpClient.Do(req)
+func (client *Client) List(url_ string, params interface{}, result interface{}) (*http.Response, []byte, error) {
+	return client.ListContext(context.Background(), url_, params, result)
+}
+func (client *Client) ListContext(ctx context.Context, url_ string, params interface{}, result interface{}) (*http.Response, []byte, error) {
+	finalURL, err := withQuery(url_, params)
 	if err != nil {
 		return nil, nil, err
 	}
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
+	req, err := http.NewRequestWithContext(ctx, "GET", finalURL, nil)
 	if err != nil {
-		return resp, body, err
+		return nil, nil, err
 	}
 
-	if client.options.Debug {
-		log.Printf("Response: %s, Body: %s\\n", resp.Status, string(body))
-	}
+	req.Header.Set("Accept", "application/json")
 
-	err = unmarshallResponse(resp, body, result)
-	return resp, body, err
+	return client.do(req, result)
 }
 func (client *Client) Create(url string, content interface{}, result interface{}) (*http.Response, []byte, error) {
+	return client.CreateContext(context.Background(), url, content, result)
+}
+func (client *Client) CreateContext(ctx context.Context, url string, content interface{}, result interface{}) (*http.Response, []byte, error) {
 	contentVal := unpackInterfacePointer(content)
 	jsonBody, err := json.Marshal(contentVal)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error marshalling content: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
 	if err != nil {
 		return nil, nil, err
 	}
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-	client.options.applyAuth(req)
-
-	if client.options.Debug {
-		log.Printf("Request: POST %s, Body: %s\\n", url, string(jsonBody))
-	}
-
-	resp, err := client.httpClient.Do(req)
-	if err != nil {
-		return nil, jsonBody, err // return jsonBody as it might be useful for debugging
-	}
-	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return resp, body, err
-	}
-
-	if client.options.Debug {
-		log.Printf("Response: %s, Body: %s\\n", resp.Status, string(body))
+	resp, body, err := client.do(req, result)
+	if resp == nil {
+		return nil, jsonBody, err
 	}
-
-	err = unmarshallResponse(resp, body, result)
 	return resp, body, err
 }
 func (client *Client) Get(url string, params interface{}, result interface{}) (*http.Response, []byte, error) {
+	return client.GetContext(context.Background(), url, params, result)
+}
+func (client *Client) GetContext(ctx context.Context, url string, params interface{}, result interface{}) (*http.Response, []byte, error) {
 	// Similar to List, using GET
-	req, err := http.NewRequest("GET", url, nil)
+	finalURL, err := withQuery(url, params)
 	if err != nil {
 		return nil, nil, err
 	}
-
-	if params != nil {
-		// Simplified: convert params to query string (needs improvement for complex types)
-		q := req.URL.Query()
-		jsonParams, _ := json.Marshal(params)
-		var mapParams map[string]interface{}
-		_ = json.Unmarshal(jsonParams, &mapParams)
-		for k, v := range mapParams {
-			// Handle slices and other types appropriately
-			switch val := v.(type) {
-			case []interface{}:
-				for _, item := range val {
-					q.Add(k, fmt.Sprintf("%v", item))
-				}
-			default:
-				q.Add(k, fmt.Sprintf("%v", val))
-			}
-		}
-		req.URL.RawQuery = q.Encode()
-	}
-
-	req.Header.Set("Accept", "application/json")
-	client.options.applyAuth(req)
-
-	if client.options.Debug {
-		log.Printf("Request: GET %s, Params: %+v\\n", url, params)
-	}
-
-	resp, err := client.httpClient.Do(req)
+	req, err := http.NewRequestWithContext(ctx, "GET", finalURL, nil)
 	if err != nil {
 		return nil, nil, err
 	}
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return resp, body, err
-	}
 
-	if client.options.Debug {
-		log.Printf("Response: %s, Body: %s\\n", resp.Status, string(body))
-	}
+	req.Header.Set("Accept", "application/json")
 
-	err = unmarshallResponse(resp, body, result)
-	return resp, body, err
+	return client.do(req, result)
 }
 func (client *Client) Update(url string, content interface{}, result interface{}) (*http.Response, []byte, error) {
+	return client.UpdateContext(context.Background(), url, content, result)
+}
+func (client *Client) UpdateContext(ctx context.Context, url string, content interface{}, result interface{}) (*http.Response, []byte, error) {
 	contentVal := unpackInterfacePointer(content)
 	jsonBody, err := json.Marshal(contentVal)
 	if err != nil {
@@ -330,7 +393,7 @@ func (client *Client) Update(url string, content interface{}, result interface{}
 
 	// WordPress API might expect PUT or POST with X-HTTP-Method-Override
 	// The original code used POST with X-HTTP-Method-Override: PUT
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -338,141 +401,42 @@ func (client *Client) Update(url string, content interface{}, result interface{}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("X-HTTP-Method-Override", "PUT") // As per original logic
-	client.options.applyAuth(req)
-
-	if client.options.Debug {
-		log.Printf("Request: POST (Update via X-HTTP-Method-Override: PUT) %s, Body: %s\\n", url, string(jsonBody))
-	}
 
-	resp, err := client.httpClient.Do(req)
-	if err != nil {
+	resp, body, err := client.do(req, result)
+	if resp == nil {
 		return nil, jsonBody, err
 	}
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return resp, body, err
-	}
-
-	if client.options.Debug {
-		log.Printf("Response: %s, Body: %s\\n", resp.Status, string(body))
-	}
-
-	err = unmarshallResponse(resp, body, result)
 	return resp, body, err
 }
 func (client *Client) Delete(url_ string, params interface{}, result interface{}) (*http.Response, []byte, error) {
-	// Original code used GET with _method=DELETE and X-HTTP-Method-Override: DELETE
-	// Standard REST practice is to use the DELETE HTTP method.
-	// Let's try with actual DELETE first, then consider the override if WP API requires it.
-	req, err := http.NewRequest("DELETE", url_, nil)
+	return client.DeleteContext(context.Background(), url_, params, result)
+}
+func (client *Client) DeleteContext(ctx context.Context, url_ string, params interface{}, result interface{}) (*http.Response, []byte, error) {
+	// Sent as GET with _method=DELETE and X-HTTP-Method-Override: DELETE, as
+	// the original gorequest code did, for servers and proxies that do not
+	// pass the DELETE method through.
+	finalURL, err := withQuery(url_, params)
 	if err != nil {
 		return nil, nil, err
 	}
-
-	if params != nil {
-		q := req.URL.Query()
-		// Assuming params is a struct or map[string]...
-		jsonParams, _ := json.Marshal(params)
-		var mapParams map[string]interface{}
-		_ = json.Unmarshal(jsonParams, &mapParams)
-		for k, v := range mapParams {
-			q.Add(k, fmt.Sprintf("%v", v))
-		}
-		req.URL.RawQuery = q.Encode()
-	}
-	// The original code also added _method=DELETE as a query param.
-	// And X-HTTP-Method-Override: DELETE header.
-	// This suggests the server might not directly support DELETE method on the URL,
-	// or there's a proxy/firewall issue.
-	// For now, let's replicate the original behavior more closely if direct DELETE fails.
-	// Sticking to original: GET with override
-	// req, err = http.NewRequest("GET", url, nil) // Re-init for GET
-	// if err != nil {
-	// 	return nil, nil, err
-	// }
-	// if params != nil {
-	// 	q := req.URL.Query()
-	// 	jsonParams, _ := json.Marshal(params)
-	// 	var mapParams map[string]interface{}
-	// 	_ = json.Unmarshal(jsonParams, &mapParams)
-	// 	for k, v := range mapParams {
-	// 		q.Add(k, fmt.Sprintf("%v", v))
-	// 	}
-	//  q.Add("_method", "DELETE") // Original behavior
-	// 	req.URL.RawQuery = q.Encode()
-	// }
-	// req.Header.Set("X-HTTP-Method-Override", "DELETE") // Original behavior
-
-	// Let's try a proper DELETE request first. If WP API has issues, we can revert to override.
-	// If the API truly needs GET with override:
-	// The original code was:
-	// req := client.req.Get(url).Query(params).Query("_method=DELETE")
-	// req.Set("HTTP_X_HTTP_METHOD_OVERRIDE", "DELETE")
-	// This means it was a GET request with these items.
-
-	// Replicating original logic for DELETE:
-	actualURL := url_
-	if params != nil {
-		// Convert params to query string
-		// This is a simplified version. A robust solution would use a library or more detailed reflection.
-		urlValues := make(url.Values)
-		jsonParams, _ := json.Marshal(params) // Error handling omitted for brevity
-		var mapParams map[string]interface{}
-		_ = json.Unmarshal(jsonParams, &mapParams) // Error handling omitted
-		for k, v := range mapParams {
-			switch val := v.(type) {
-			case []interface{}:
-				for _, item := range val {
-					urlValues.Add(k, fmt.Sprintf("%v", item))
-				}
-			default:
-				urlValues.Add(k, fmt.Sprintf("%v", val))
-			}
-		}
-		if len(urlValues) > 0 {
-			actualURL = fmt.Sprintf("%s?%s", actualURL, urlValues.Encode())
-		}
+	finalURL, err = withQuery(finalURL, url.Values{"_method": {"DELETE"}})
+	if err != nil {
+		return nil, nil, err
 	}
-	// Add _method=DELETE to the query string for the GET request
-	parsedURL, _ := url.Parse(actualURL)
-	query := parsedURL.Query()
-	query.Add("_method", "DELETE")
-	parsedURL.RawQuery = query.Encode()
-	finalURL := parsedURL.String()
 
-	req, err = http.NewRequest("GET", finalURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", finalURL, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 	req.Header.Set("X-HTTP-Method-Override", "DELETE")
 	req.Header.Set("Accept", "application/json") // Assuming JSON response for delete status/message
-	client.options.applyAuth(req)
-
-	if client.options.Debug {
-		log.Printf("Request: GET (Delete via X-HTTP-Method-Override) %s, Params: %+v\\n", finalURL, params)
-	}
 
-	resp, err := client.httpClient.Do(req)
-	if err != nil {
-		return nil, nil, err // No body to return on request error
-	}
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return resp, body, err
-	}
-
-	if client.options.Debug {
-		log.Printf("Response: %s, Body: %s\\n", resp.Status, string(body))
-	}
-
-	err = unmarshallResponse(resp, body, result) // gorequest.End() was used, implies body might not always be JSON
-	return resp, body, err
+	return client.do(req, result)
 }
 func (client *Client) PostData(url string, content []byte, contentType string, filename string, result interface{}) (*http.Response, []byte, error) {
+	return client.PostDataContext(context.Background(), url, content, contentType, filename, result)
+}
+func (client *Client) PostDataContext(ctx context.Context, url string, content []byte, contentType string, filename string, result interface{}) (*http.Response, []byte, error) {
 	// The original comment said: "// gorequest does not support POST-ing raw data"
 	// net/http supports this directly.
 	// The original code snippet for PostData was incomplete but started with:
@@ -481,7 +445,7 @@ func (client *Client) PostData(url string, content []byte, contentType string, f
 	// It seems it was trying to build a multipart request or a raw post.
 	// Given `contentType` and `filename`, this is likely for file uploads (media).
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(content))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(content))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -500,27 +464,24 @@ func (client *Client) PostData(url string, content []byte, contentType string, f
 	}
 
 	req.Header.Set("Accept", "application/json") // Assuming JSON response
-	client.options.applyAuth(req)
 
-	if client.options.Debug {
-		log.Printf("Request: POST %s, ContentType: %s, Filename: %s, ContentLength: %d\\n", url, contentType, filename, len(content))
-	}
-
-	resp, err := client.httpClient.Do(req)
-	if err != nil {
-		return nil, content, err // Return original content for debugging
+	resp, body, err := client.do(req, result)
+	if resp == nil {
+		return nil, content, err
 	}
-	defer resp.Body.Close()
+	return resp, body, err
+}
 
-	body, err := ioutil.ReadAll(resp.Body)
+// do sends req and decodes the response body into result. The request is
+// bound to its context, so cancelling it aborts the call, including any
+// request body still being uploaded. Failed attempts are retried according
+// to Options.Retry.
+func (client *Client) do(req *http.Request, result interface{}) (*http.Response, []byte, error) {
+	resp, body, err := client.send(req)
 	if err != nil {
 		return resp, body, err
 	}
 
-	if client.options.Debug {
-		log.Printf("Response: %s, Body: %s\\n", resp.Status, string(body))
-	}
-
 	err = unmarshallResponse(resp, body, result)
 	return resp, body, err
 }
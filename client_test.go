@@ -1,8 +1,18 @@
 package wordpress_test
 
 import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/eideroliveira/wordpress"
 )
@@ -39,3 +49,190 @@ func initTestClient() *wordpress.Client {
 		Password:   PASSWORD,
 	})
 }
+
+// initTestServerClient creates a wordpress client pointed at a local test
+// server, for tests that do not need a live Wordpress installation
+func initTestServerClient(t *testing.T, handler http.HandlerFunc) *wordpress.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return wordpress.NewClient(&wordpress.Options{
+		BaseAPIURL: server.URL,
+		Username:   "user",
+		Password:   "password",
+	})
+}
+
+// initTestRoutesClient creates a wordpress client pointed at a local test
+// server serving routes keyed by method and escaped path, e.g.
+// "PUT /posts/1". The method is taken from X-HTTP-Method-Override when set,
+// as for updates and deletes. Any other request fails the test.
+func initTestRoutesClient(t *testing.T, routes map[string]http.HandlerFunc) *wordpress.Client {
+	return initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method := r.Method
+		if override := r.Header.Get("X-HTTP-Method-Override"); override != "" {
+			method = override
+		}
+		route, ok := routes[method+" "+r.URL.EscapedPath()]
+		if !ok {
+			t.Errorf("Unexpected request: %v %v", method, r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		route(w, r)
+	})
+}
+
+func TestClientGetContext_Cancelled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, _, _, err := wp.Posts().GetContext(ctx, 1, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestClientPostDataContext_Cancelled(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Request should not reach the server")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, _, err := wp.Media().CreateContext(ctx, &wordpress.MediaUploadOptions{
+		Filename:    "test-media.jpg",
+		ContentType: "image/jpeg",
+		Data:        []byte("data"),
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClientNew_Transport(t *testing.T) {
+	calls := 0
+	wp := wordpress.NewClient(&wordpress.Options{
+		BaseAPIURL: "http://wordpress.invalid/wp-json/wp/v2",
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`[{"id":1}]`)),
+				Request:    req,
+			}, nil
+		}),
+	})
+
+	posts, _, _, err := wp.Posts().List(nil)
+	if err != nil {
+		t.Errorf("Should not return error: %v", err.Error())
+	}
+	if calls != 1 || len(posts) != 1 {
+		t.Errorf("Request should go through the injected transport")
+	}
+}
+
+func TestClientNew_HTTPClientRedirectKeepsAuth(t *testing.T) {
+	redirects := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/posts", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/moved/posts", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/moved/posts", func(w http.ResponseWriter, r *http.Request) {
+		if user, _, ok := r.BasicAuth(); !ok || user != "user" {
+			t.Errorf("Auth should be re-applied on redirect")
+		}
+		w.Write([]byte(`[]`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	httpClient := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			redirects++
+			return nil
+		},
+	}
+	wp := wordpress.NewClient(&wordpress.Options{
+		BaseAPIURL: server.URL,
+		Username:   "user",
+		Password:   "password",
+		HTTPClient: httpClient,
+	})
+
+	_, _, _, err := wp.Posts().List(nil)
+	if err != nil {
+		t.Errorf("Should not return error: %v", err.Error())
+	}
+	if redirects != 1 {
+		t.Errorf("Injected CheckRedirect should be called, got %v calls", redirects)
+	}
+	if httpClient.Transport != nil {
+		t.Errorf("Injected http.Client should not be modified")
+	}
+}
+
+func TestClientNew_KeepAlive(t *testing.T) {
+	var conns int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&conns, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	wp := wordpress.NewClient(&wordpress.Options{BaseAPIURL: server.URL})
+	for i := 0; i < 3; i++ {
+		if _, _, _, err := wp.Posts().List(nil); err != nil {
+			t.Fatalf("Should not return error: %v", err.Error())
+		}
+	}
+	if conns != 1 {
+		t.Errorf("Expected connection to be reused, got %v connections", conns)
+	}
+}
+
+func TestClientNew_RootCAs(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	wp := wordpress.NewClient(&wordpress.Options{BaseAPIURL: server.URL})
+	if _, _, _, err := wp.Posts().List(nil); err == nil {
+		t.Errorf("Should not trust the test server certificate by default")
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	wp = wordpress.NewClient(&wordpress.Options{
+		BaseAPIURL: server.URL,
+		RootCAs:    pool,
+	})
+	if _, _, _, err := wp.Posts().List(nil); err != nil {
+		t.Errorf("Should not return error: %v", err.Error())
+	}
+}
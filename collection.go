@@ -0,0 +1,255 @@
+package wordpress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// Collection is a generic collection of WP-API resources decoded into T,
+// with the usual List/Get/Create/Update/Delete calls. The built-in
+// collections are built on it, and it can be used directly for endpoints the
+// library does not model:
+//
+//	type Product struct {
+//		ID   int    `json:"id,omitempty"`
+//		Name string `json:"name,omitempty"`
+//	}
+//	products := wordpress.NewCollection[Product](client, "products")
+//	product, resp, body, err := products.Get(10, nil)
+type Collection[T any] struct {
+	client *Client
+	url    string
+
+	// bind is called on every entity returned by the collection, so entities
+	// with sub-collections can keep a reference to their parent collection.
+	bind func(entity *T)
+}
+
+// NewCollection returns a collection of T at path, relative to
+// Options.BaseAPIURL, or at path itself if it is an absolute URL.
+func NewCollection[T any](client *Client, path string) *Collection[T] {
+	return newCollection[T](client, client.collectionURL(path), nil)
+}
+
+func newCollection[T any](client *Client, url string, bind func(entity *T)) *Collection[T] {
+	return &Collection[T]{
+		client: client,
+		url:    url,
+		bind:   bind,
+	}
+}
+
+func (client *Client) collectionURL(path string) string {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+	return fmt.Sprintf("%v/%v", client.baseURL, strings.TrimPrefix(path, "/"))
+}
+
+// URL returns the URL of the collection.
+func (col *Collection[T]) URL() string {
+	return col.url
+}
+
+func (col *Collection[T]) setCollection(entity *T) {
+	if col.bind != nil {
+		col.bind(entity)
+	}
+}
+
+func (col *Collection[T]) List(params interface{}) ([]T, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *Collection[T]) ListContext(ctx context.Context, params interface{}) ([]T, *http.Response, []byte, error) {
+	var entities []T
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &decodedList[T]{entities: &entities})
+
+	// set collection object for each entity which has sub-collection
+	for i := range entities {
+		col.setCollection(&entities[i])
+	}
+
+	return entities, resp, body, err
+}
+
+// All returns an iterator over all entities matching params, fetching further
+// pages as needed. Iteration stops at the first error.
+func (col *Collection[T]) All(ctx context.Context, params interface{}) iter.Seq2[T, error] {
+	return paginate(ctx, col.client, col.url, params, col.bind)
+}
+func (col *Collection[T]) Create(new *T) (*T, *http.Response, []byte, error) {
+	return col.CreateContext(context.Background(), new)
+}
+func (col *Collection[T]) CreateContext(ctx context.Context, new *T) (*T, *http.Response, []byte, error) {
+	var created T
+	content, err := encodeEntity(new)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	resp, body, err := col.client.CreateContext(ctx, col.url, content, &decodedEntity[T]{entity: &created})
+
+	col.setCollection(&created)
+
+	return &created, resp, body, err
+}
+func (col *Collection[T]) Get(id int, params interface{}) (*T, *http.Response, []byte, error) {
+	return col.GetContext(context.Background(), id, params)
+}
+func (col *Collection[T]) GetContext(ctx context.Context, id int, params interface{}) (*T, *http.Response, []byte, error) {
+	var entity T
+	entityURL := fmt.Sprintf("%v/%v", col.url, id)
+	resp, body, err := col.client.GetContext(ctx, entityURL, params, &decodedEntity[T]{entity: &entity})
+
+	// set collection object for each entity which has sub-collection
+	col.setCollection(&entity)
+
+	return &entity, resp, body, err
+}
+func (col *Collection[T]) Update(id int, entity *T) (*T, *http.Response, []byte, error) {
+	return col.UpdateContext(context.Background(), id, entity)
+}
+func (col *Collection[T]) UpdateContext(ctx context.Context, id int, entity *T) (*T, *http.Response, []byte, error) {
+	var updated T
+	entityURL := fmt.Sprintf("%v/%v", col.url, id)
+	content, err := encodeEntity(entity)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	resp, body, err := col.client.UpdateContext(ctx, entityURL, content, &decodedEntity[T]{entity: &updated})
+
+	// set collection object for each entity which has sub-collection
+	col.setCollection(&updated)
+
+	return &updated, resp, body, err
+}
+func (col *Collection[T]) Delete(id int, params interface{}) (*T, *http.Response, []byte, error) {
+	return col.DeleteContext(context.Background(), id, params)
+}
+func (col *Collection[T]) DeleteContext(ctx context.Context, id int, params interface{}) (*T, *http.Response, []byte, error) {
+	var deleted T
+	entityURL := fmt.Sprintf("%v/%v", col.url, id)
+	resp, body, err := col.client.DeleteContext(ctx, entityURL, params, &deletedEntity[T]{entity: &deleted})
+
+	// set collection object for each entity which has sub-collection
+	col.setCollection(&deleted)
+
+	return &deleted, resp, body, err
+}
+
+// ForceDelete deletes the entity id bypassing the trash, as required by
+// resources that cannot be trashed such as terms and menus, and returns it.
+func (col *Collection[T]) ForceDelete(id int) (*T, *http.Response, []byte, error) {
+	return col.ForceDeleteContext(context.Background(), id)
+}
+func (col *Collection[T]) ForceDeleteContext(ctx context.Context, id int) (*T, *http.Response, []byte, error) {
+	return col.DeleteContext(ctx, id, DeleteParams{Force: true})
+}
+
+// deletedEntity decodes the response of a Delete call into entity. Resources
+// deleted with `force=true` are returned as `{"deleted":true,"previous":{...}}`,
+// trashed ones as the resource itself.
+type deletedEntity[T any] struct {
+	entity *T
+}
+
+func (d *deletedEntity[T]) UnmarshalJSON(b []byte) error {
+	var wrapped struct {
+		Deleted  *bool           `json:"deleted"`
+		Previous json.RawMessage `json:"previous"`
+	}
+	if err := json.Unmarshal(b, &wrapped); err == nil && wrapped.Deleted != nil && wrapped.Previous != nil {
+		b = wrapped.Previous
+	}
+	return (&decodedEntity[T]{entity: d.entity}).UnmarshalJSON(b)
+}
+
+// rawDecoder is implemented by entities that read response fields not
+// declared in their struct, such as the taxonomy terms of *Post. known holds
+// the JSON names of the declared fields of the decoded type.
+type rawDecoder interface {
+	decodeRaw(raw []byte, known map[string]bool)
+}
+
+// rawEncoder is implemented by entities that send fields not declared in
+// their struct, or send declared ones differently. A nil value removes the
+// field from the request.
+type rawEncoder interface {
+	encodeRaw() map[string]interface{}
+}
+
+// decodedEntity decodes a response into entity, then lets it read any
+// undeclared fields.
+type decodedEntity[T any] struct {
+	entity *T
+}
+
+func (d *decodedEntity[T]) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, d.entity); err != nil {
+		return err
+	}
+	if decoder, ok := any(d.entity).(rawDecoder); ok {
+		t := reflect.TypeOf(d.entity).Elem()
+		if t.Kind() == reflect.Struct {
+			decoder.decodeRaw(b, jsonFields(t))
+		}
+	}
+	return nil
+}
+
+// decodedList decodes a list response with decodedEntity.
+type decodedList[T any] struct {
+	entities *[]T
+}
+
+func (d *decodedList[T]) UnmarshalJSON(b []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(b, &raws); err != nil {
+		return err
+	}
+	if raws == nil {
+		*d.entities = nil
+		return nil
+	}
+	entities := make([]T, len(raws))
+	for i, raw := range raws {
+		if err := (&decodedEntity[T]{entity: &entities[i]}).UnmarshalJSON(raw); err != nil {
+			return err
+		}
+	}
+	*d.entities = entities
+	return nil
+}
+
+// encodeEntity returns the request body for entity, with the undeclared
+// fields of a rawEncoder merged in.
+func encodeEntity[T any](entity *T) (interface{}, error) {
+	encoder, ok := any(entity).(rawEncoder)
+	if !ok || entity == nil {
+		return entity, nil
+	}
+	extra := encoder.encodeRaw()
+	if len(extra) == 0 {
+		return entity, nil
+	}
+	b, err := json.Marshal(entity)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling content: %w", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, fmt.Errorf("error marshalling content: %w", err)
+	}
+	for name, value := range extra {
+		if value == nil {
+			delete(fields, name)
+			continue
+		}
+		fields[name] = value
+	}
+	return fields, nil
+}
@@ -0,0 +1,109 @@
+package wordpress_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+type testProduct struct {
+	ID    int    `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Price string `json:"price,omitempty"`
+}
+
+func initTestProductsClient(t *testing.T) *wordpress.Client {
+	return initTestRoutesClient(t, map[string]http.HandlerFunc{
+		"GET /wc/v3/products": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`[{"id":1,"name":"Mug","price":"9.90"},{"id":2,"name":"Shirt","price":"19.90"}]`))
+		},
+		"GET /wc/v3/products/1": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"id":1,"name":"Mug","price":"9.90"}`))
+		},
+		"POST /wc/v3/products": func(w http.ResponseWriter, r *http.Request) {
+			var p testProduct
+			json.NewDecoder(r.Body).Decode(&p)
+			p.ID = 3
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(p)
+		},
+		"PUT /wc/v3/products/1": func(w http.ResponseWriter, r *http.Request) {
+			var p testProduct
+			json.NewDecoder(r.Body).Decode(&p)
+			p.ID = 1
+			json.NewEncoder(w).Encode(p)
+		},
+		"DELETE /wc/v3/products/1": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"id":1,"name":"Mug"}`))
+		},
+	})
+}
+
+func TestCollection_CustomResource(t *testing.T) {
+	wp := initTestProductsClient(t)
+	products := wordpress.NewCollection[testProduct](wp, "/wc/v3/products")
+	if !strings.HasSuffix(products.URL(), "/wc/v3/products") {
+		t.Errorf("Unexpected collection URL: %v", products.URL())
+	}
+
+	list, _, _, err := products.List(nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if len(list) != 2 || list[1].Name != "Shirt" {
+		t.Errorf("Unexpected products: %+v", list)
+	}
+
+	product, _, _, err := products.Get(1, nil)
+	if err != nil || product.Price != "9.90" {
+		t.Errorf("Unexpected product: %+v, %v", product, err)
+	}
+
+	created, resp, _, err := products.Create(&testProduct{Name: "Cap"})
+	if err != nil || resp.StatusCode != http.StatusCreated || created.ID != 3 || created.Name != "Cap" {
+		t.Errorf("Unexpected created product: %+v, %v", created, err)
+	}
+
+	updated, _, _, err := products.Update(1, &testProduct{Name: "Big mug"})
+	if err != nil || updated.Name != "Big mug" {
+		t.Errorf("Unexpected updated product: %+v, %v", updated, err)
+	}
+
+	deleted, _, _, err := products.Delete(1, wordpress.DeleteParams{Force: true})
+	if err != nil || deleted.ID != 1 {
+		t.Errorf("Unexpected deleted product: %+v, %v", deleted, err)
+	}
+
+	count := 0
+	for _, err := range products.All(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("Should not return error: %v", err.Error())
+		}
+		count++
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 products, got %v", count)
+	}
+}
+
+func TestCollection_PostsKeepParentCollection(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":7}`))
+	})
+
+	post, _, _, err := wp.Posts().Get(7, nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	meta := post.Meta()
+	if meta == nil {
+		t.Fatalf("Post fetched from the API should have a meta sub-collection")
+	}
+	if post.Revisions() == nil || post.Terms() == nil {
+		t.Errorf("Post fetched from the API should have sub-collections")
+	}
+}
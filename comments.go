@@ -1,8 +1,7 @@
 package wordpress
 
 import (
-	"fmt"
-	"net/http"
+	"time"
 )
 
 type Comment struct {
@@ -26,36 +25,23 @@ type Comment struct {
 	Type            string     `json:"type,omitempty"`
 }
 
-type CommentsCollection struct {
-	client *Client
-	url    string
-}
+// CommentListParams are the query parameters of CommentsCollection.List.
+type CommentListParams struct {
+	ListParams
 
-func (col *CommentsCollection) List(params interface{}) ([]Comment, *http.Response, []byte, error) {
-	var comments []Comment
-	resp, body, err := col.client.List(col.url, params, &comments)
-	return comments, resp, body, err
-}
-func (col *CommentsCollection) Create(new *Comment) (*Comment, *http.Response, []byte, error) {
-	var created Comment
-	resp, body, err := col.client.Create(col.url, new, &created)
-	return &created, resp, body, err
-}
-func (col *CommentsCollection) Get(id int, params interface{}) (*Comment, *http.Response, []byte, error) {
-	var entity Comment
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Get(entityURL, params, &entity)
-	return &entity, resp, body, err
+	After         time.Time `url:"after,omitempty"`
+	Before        time.Time `url:"before,omitempty"`
+	Author        []int     `url:"author,omitempty"`
+	AuthorExclude []int     `url:"author_exclude,omitempty"`
+	AuthorEmail   string    `url:"author_email,omitempty"`
+	Parent        []int     `url:"parent,omitempty"`
+	ParentExclude []int     `url:"parent_exclude,omitempty"`
+	Post          []int     `url:"post,omitempty"`
+	Status        string    `url:"status,omitempty"`
+	Type          string    `url:"type,omitempty"`
+	Password      string    `url:"password,omitempty"`
 }
-func (col *CommentsCollection) Update(id int, post *Comment) (*Comment, *http.Response, []byte, error) {
-	var updated Comment
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Update(entityURL, post, &updated)
-	return &updated, resp, body, err
-}
-func (col *CommentsCollection) Delete(id int, params interface{}) (*Comment, *http.Response, []byte, error) {
-	var deleted Comment
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Delete(entityURL, params, &deleted)
-	return &deleted, resp, body, err
+
+type CommentsCollection struct {
+	*Collection[Comment]
 }
@@ -0,0 +1,193 @@
+package wordpress
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	ErrorCodeInvalidParam = "rest_invalid_param"
+	ErrorCodeMissingParam = "rest_missing_callback_param"
+	ErrorCodeForbidden    = "rest_forbidden"
+	ErrorCodeNoRoute      = "rest_no_route"
+	ErrorCodePostInvalid  = "rest_post_invalid_id"
+	ErrorCodeTermInvalid  = "rest_term_invalid"
+	ErrorCodeUserInvalid  = "rest_user_invalid_id"
+)
+
+// GeneralErrorData is the `data` member of a WP-API error response.
+type GeneralErrorData struct {
+	Status  int                     `json:"status,omitempty"`
+	Params  ErrorParams             `json:"params,omitempty"`
+	Details map[string]GeneralError `json:"details,omitempty"`
+}
+
+// UnmarshalJSON accepts the object form sent by WP-API as well as the bare
+// status code some plugins send instead.
+func (data *GeneralErrorData) UnmarshalJSON(b []byte) error {
+	var status int
+	if err := json.Unmarshal(b, &status); err == nil {
+		data.Status = status
+		return nil
+	}
+	if b[0] != '{' {
+		// null, strings and other shapes carry nothing we can use
+		return nil
+	}
+	type plain GeneralErrorData
+	return json.Unmarshal(b, (*plain)(data))
+}
+
+// ErrorParams maps the parameter names of an error to the reason they were
+// rejected. `rest_missing_callback_param` lists the missing names instead,
+// which decode with an empty reason.
+type ErrorParams map[string]string
+
+func (params *ErrorParams) UnmarshalJSON(b []byte) error {
+	var names []string
+	if err := json.Unmarshal(b, &names); err == nil {
+		*params = make(ErrorParams, len(names))
+		for _, name := range names {
+			(*params)[name] = ""
+		}
+		return nil
+	}
+	reasons := map[string]string{}
+	if err := json.Unmarshal(b, &reasons); err != nil {
+		return err
+	}
+	*params = reasons
+	return nil
+}
+
+// APIError is returned for every response with a non-successful status code.
+// Use errors.As to retrieve it, or one of the Is* helpers to branch on it.
+type APIError struct {
+	// StatusCode and Status are taken from the HTTP response.
+	StatusCode int
+	Status     string
+
+	// Code, Message and Data are decoded from the WP-API error body, e.g.
+	// `rest_post_invalid_id`. They are empty if the body was not a WP error.
+	Code    string
+	Message string
+	Data    GeneralErrorData
+
+	// Body is the raw response body.
+	Body []byte
+}
+
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       body,
+	}
+	var generalErr struct {
+		Code    string          `json:"code"`
+		Message string          `json:"message"`
+		Data    json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &generalErr); err != nil {
+		return apiErr
+	}
+	apiErr.Code = generalErr.Code
+	apiErr.Message = generalErr.Message
+	// a data member we cannot decode must not hide the code and message
+	if len(generalErr.Data) > 0 {
+		_ = json.Unmarshal(generalErr.Data, &apiErr.Data)
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("wordpress: %v", e.Status)
+	}
+	msg := fmt.Sprintf("wordpress: %v: %v (%v)", e.Status, e.Message, e.Code)
+	if len(e.Data.Params) > 0 {
+		params := make([]string, 0, len(e.Data.Params))
+		for name, reason := range e.Data.Params {
+			if reason == "" {
+				params = append(params, name)
+				continue
+			}
+			params = append(params, fmt.Sprintf("%v: %v", name, reason))
+		}
+		msg += " [" + strings.Join(params, "; ") + "]"
+	}
+	return msg
+}
+
+// InvalidParams returns the map of invalid parameter names to the reason
+// WP-API rejected them. Missing parameters have an empty reason.
+func (e *APIError) InvalidParams() map[string]string {
+	return e.Data.Params
+}
+
+// AsAPIError reports whether err is (or wraps) an *APIError and returns it.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// HasErrorCode reports whether err is an *APIError with the given WP-API code.
+func HasErrorCode(err error, code string) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.Code == code
+}
+
+// IsNotFound reports whether err is an *APIError for a 404 response.
+func IsNotFound(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err is an *APIError for a 401 response,
+// which WP-API sends when the request is not authenticated.
+func IsUnauthorized(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether err is an *APIError for a 403 response, or for
+// a 401 response carrying a `rest_forbidden*` or `rest_cannot_*` code.
+func IsForbidden(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusForbidden {
+		return true
+	}
+	return apiErr.StatusCode == http.StatusUnauthorized &&
+		(strings.HasPrefix(apiErr.Code, ErrorCodeForbidden) || strings.HasPrefix(apiErr.Code, "rest_cannot_"))
+}
+
+// IsInvalidParam reports whether err is an *APIError rejecting request
+// parameters. If names are given, at least one of them must be among the
+// invalid parameters.
+func IsInvalidParam(err error, names ...string) bool {
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return false
+	}
+	if apiErr.Code != ErrorCodeInvalidParam && apiErr.Code != ErrorCodeMissingParam {
+		return false
+	}
+	if len(names) == 0 {
+		return true
+	}
+	for _, name := range names {
+		if _, ok := apiErr.Data.Params[name]; ok {
+			return true
+		}
+	}
+	return false
+}
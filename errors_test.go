@@ -0,0 +1,150 @@
+package wordpress_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestAPIError_NotFound(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":"rest_post_invalid_id","message":"Invalid post ID.","data":{"status":404}}`))
+	})
+
+	_, resp, body, err := wp.Posts().Get(-1, nil)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 NotFound, got %v", resp.Status)
+	}
+	var apiErr *wordpress.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *wordpress.APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected StatusCode 404, got %v", apiErr.StatusCode)
+	}
+	if apiErr.Code != wordpress.ErrorCodePostInvalid {
+		t.Errorf("Unexpected error code: %v", apiErr.Code)
+	}
+	if apiErr.Message != "Invalid post ID." {
+		t.Errorf("Unexpected error message: %v", apiErr.Message)
+	}
+	if apiErr.Data.Status != http.StatusNotFound {
+		t.Errorf("Expected data.status 404, got %v", apiErr.Data.Status)
+	}
+	if string(apiErr.Body) != string(body) {
+		t.Errorf("Error body should be the raw response body")
+	}
+	if !wordpress.IsNotFound(err) {
+		t.Errorf("IsNotFound should be true")
+	}
+	if wordpress.IsForbidden(err) {
+		t.Errorf("IsForbidden should be false")
+	}
+	if !wordpress.HasErrorCode(err, wordpress.ErrorCodePostInvalid) {
+		t.Errorf("HasErrorCode should be true")
+	}
+}
+
+func TestAPIError_InvalidParam(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":"rest_invalid_param","message":"Invalid parameter(s): per_page","data":{"status":400,"params":{"per_page":"per_page must be between 1 (inclusive) and 100 (inclusive)"},"details":{"per_page":{"code":"rest_out_of_bounds","message":"per_page must be between 1 (inclusive) and 100 (inclusive)","data":null}}}}`))
+	})
+
+	_, _, _, err := wp.Posts().List(nil)
+	if !wordpress.IsInvalidParam(err) {
+		t.Errorf("IsInvalidParam should be true")
+	}
+	if !wordpress.IsInvalidParam(err, "per_page") {
+		t.Errorf("IsInvalidParam should be true for per_page")
+	}
+	if wordpress.IsInvalidParam(err, "search") {
+		t.Errorf("IsInvalidParam should be false for search")
+	}
+	apiErr, ok := wordpress.AsAPIError(err)
+	if !ok {
+		t.Fatalf("Expected *wordpress.APIError, got %T", err)
+	}
+	if len(apiErr.InvalidParams()) != 1 {
+		t.Errorf("Expected one invalid param, got %v", apiErr.InvalidParams())
+	}
+	if apiErr.Data.Details["per_page"].Code != "rest_out_of_bounds" {
+		t.Errorf("Unexpected details: %v", apiErr.Data.Details)
+	}
+}
+
+func TestAPIError_MissingParam(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":"rest_missing_callback_param","message":"Missing parameter(s): title","data":{"status":400,"params":["title"]}}`))
+	})
+
+	_, _, _, err := wp.Posts().List(nil)
+	if !wordpress.IsInvalidParam(err) {
+		t.Errorf("IsInvalidParam should be true")
+	}
+	if !wordpress.IsInvalidParam(err, "title") {
+		t.Errorf("IsInvalidParam should be true for title")
+	}
+	apiErr, ok := wordpress.AsAPIError(err)
+	if !ok {
+		t.Fatalf("Expected *wordpress.APIError, got %T", err)
+	}
+	if apiErr.Code != wordpress.ErrorCodeMissingParam || apiErr.Data.Status != http.StatusBadRequest {
+		t.Errorf("Unexpected error: %+v", apiErr)
+	}
+	if !strings.HasSuffix(err.Error(), "[title]") {
+		t.Errorf("Unexpected message: %v", err)
+	}
+}
+
+func TestAPIError_UndecodableData(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":"rest_invalid_param","message":"Invalid parameter(s): per_page","data":{"status":400,"params":42}}`))
+	})
+
+	_, _, _, err := wp.Posts().List(nil)
+	if !wordpress.HasErrorCode(err, wordpress.ErrorCodeInvalidParam) {
+		t.Errorf("Code should be decoded, got %v", err)
+	}
+}
+
+func TestAPIError_Forbidden(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"code":"rest_cannot_create","message":"Sorry, you are not allowed to create posts as this user.","data":{"status":401}}`))
+	})
+
+	p := factoryPost()
+	_, _, _, err := wp.Posts().Create(&p)
+	if !wordpress.IsUnauthorized(err) {
+		t.Errorf("IsUnauthorized should be true")
+	}
+	if !wordpress.IsForbidden(err) {
+		t.Errorf("IsForbidden should be true")
+	}
+}
+
+func TestAPIError_NonJSONBody(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(`<html>Bad Gateway</html>`))
+	})
+
+	_, _, _, err := wp.Posts().List(nil)
+	apiErr, ok := wordpress.AsAPIError(err)
+	if !ok {
+		t.Fatalf("Expected *wordpress.APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("Expected StatusCode 502, got %v", apiErr.StatusCode)
+	}
+	if apiErr.Code != "" {
+		t.Errorf("Code should be empty, got %v", apiErr.Code)
+	}
+}
@@ -0,0 +1,168 @@
+package wordpress_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+// fixtureServerClient returns a client whose server answers every request
+// with the fixture file.
+func fixtureServerClient(t *testing.T, fixture string) (*wordpress.Client, []byte) {
+	data, err := os.ReadFile("test-data/" + fixture)
+	if err != nil {
+		t.Fatalf("Should read fixture: %v", err.Error())
+	}
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(data)
+	})
+	return wp, data
+}
+
+// assertFixtureFields checks that every field of the fixture, except the
+// ignored ones, survives decoding into entity and encoding it back. A field
+// missing from the encoded entity must hold a zero value in the fixture.
+// This catches json tags that do not match the API field names.
+func assertFixtureFields(t *testing.T, fixture []byte, entity interface{}, ignored ...string) {
+	t.Helper()
+	var expected map[string]interface{}
+	if err := json.Unmarshal(fixture, &expected); err != nil {
+		t.Fatalf("Should decode fixture: %v", err.Error())
+	}
+	encoded, err := json.Marshal(entity)
+	if err != nil {
+		t.Fatalf("Should encode entity: %v", err.Error())
+	}
+	var actual map[string]interface{}
+	json.Unmarshal(encoded, &actual)
+
+	for _, field := range ignored {
+		delete(expected, field)
+	}
+	for field, value := range expected {
+		got, ok := actual[field]
+		if !ok {
+			if !isZeroJSON(value) {
+				t.Errorf("Field %v is not decoded, expected %v", field, value)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(dropZeroJSON(value), dropZeroJSON(got)) {
+			t.Errorf("Field %v: expected %v, got %v", field, value, got)
+		}
+	}
+}
+
+func isZeroJSON(value interface{}) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !value
+	case float64:
+		return value == 0
+	case string:
+		return value == ""
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		return len(dropZeroJSON(value).(map[string]interface{})) == 0
+	}
+	return false
+}
+
+// dropZeroJSON removes the zero fields of objects, which omitempty drops.
+func dropZeroJSON(value interface{}) interface{} {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	result := map[string]interface{}{}
+	for key, field := range object {
+		if !isZeroJSON(field) {
+			result[key] = dropZeroJSON(field)
+		}
+	}
+	return result
+}
+
+func TestFixture_Post(t *testing.T) {
+	wp, fixture := fixtureServerClient(t, "post.json")
+
+	post, _, _, err := wp.Posts().Get(42, "context=edit")
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	assertFixtureFields(t, fixture, post, "_links", "genres")
+
+	if post.PermalinkTemplate != "https://example.com/%postname%/" || post.GeneratedSlug != "release-notes" {
+		t.Errorf("Unexpected permalink fields: %v, %v", post.PermalinkTemplate, post.GeneratedSlug)
+	}
+	if fmt.Sprint(post.Categories, post.Tags) != "[1 5] [8]" {
+		t.Errorf("Unexpected terms: %v, %v", post.Categories, post.Tags)
+	}
+	if post.MetaFields["_wp_custom"] != "value" || post.Template != "single-wide" {
+		t.Errorf("Unexpected meta or template: %v, %v", post.MetaFields, post.Template)
+	}
+	if post.Content.BlockVersion != 1 || len(post.ClassList) != 8 {
+		t.Errorf("Unexpected content or classes: %+v, %v", post.Content, post.ClassList)
+	}
+	if fmt.Sprint(post.TaxonomyTerms) != "map[genres:[3]]" {
+		t.Errorf("Unexpected taxonomy terms: %v", post.TaxonomyTerms)
+	}
+	if post.Links.Href("author") != "https://example.com/wp-json/wp/v2/users/1" || post.Links.Href("missing") != "" {
+		t.Errorf("Unexpected links: %v", post.Links)
+	}
+	terms := post.Links["wp:term"]
+	if len(terms) != 3 || terms[1].Taxonomy != "post_tag" || !terms[1].Embeddable {
+		t.Errorf("Unexpected term links: %+v", terms)
+	}
+	if post.Links["version-history"][0].Count != 3 || post.Links["predecessor-version"][0].ID != 45 {
+		t.Errorf("Unexpected revision links: %+v", post.Links)
+	}
+}
+
+func TestFixture_Page(t *testing.T) {
+	wp, fixture := fixtureServerClient(t, "page.json")
+
+	page, _, _, err := wp.Pages().Get(12, "context=edit")
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	assertFixtureFields(t, fixture, page, "_links", "class_list")
+
+	if page.Parent != 10 || page.MenuOrder != 3 || page.Template != "page-full-width.php" {
+		t.Errorf("Unexpected hierarchy fields: %v, %v, %v", page.Parent, page.MenuOrder, page.Template)
+	}
+	if page.MetaFields == nil || len(page.MetaFields) != 0 {
+		t.Errorf("Empty meta should decode to an empty map: %#v", page.MetaFields)
+	}
+	if fmt.Sprint(page.ClassList) != "[post-12 page type-page status-publish hentry]" {
+		t.Errorf("Class list sent as an object should keep its order: %v", page.ClassList)
+	}
+	if page.Links.Href("up") != "https://example.com/wp-json/wp/v2/pages/10" {
+		t.Errorf("Unexpected links: %v", page.Links)
+	}
+	if len(page.TaxonomyTerms) != 0 {
+		t.Errorf("Unexpected taxonomy terms: %v", page.TaxonomyTerms)
+	}
+}
+
+func TestFixture_Category(t *testing.T) {
+	wp, fixture := fixtureServerClient(t, "category.json")
+
+	category, _, _, err := wp.Categories().Get(5, nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	assertFixtureFields(t, fixture, category, "_links")
+
+	if category.Count != 14 || category.Parent != 1 {
+		t.Errorf("Unexpected category: %+v", category)
+	}
+}
@@ -0,0 +1,212 @@
+package wordpress
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are replaced wholesale in log output.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Wp-Nonce",
+}
+
+// sensitiveKeys are JSON keys and query parameters whose values are never
+// logged.
+var sensitiveKeys = map[string]bool{
+	"password":      true,
+	"user_pass":     true,
+	"new_password":  true,
+	"app_password":  true,
+	"token":         true,
+	"jwt":           true,
+	"access_token":  true,
+	"refresh_token": true,
+	"secret":        true,
+	"email":         true,
+	"user_email":    true,
+	"author_email":  true,
+}
+
+var (
+	jwtPattern   = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	// appPasswordPattern matches the password of a basic-auth string such as
+	// `admin:abcd EFGH ijkl MNOP qrst UVWX`. It is only applied to
+	// credentials, as it would match six four-letter words of prose too.
+	appPasswordPattern = regexp.MustCompile(`:[A-Za-z0-9]{4}(?: [A-Za-z0-9]{4}){5}\b`)
+)
+
+// logger returns the configured logger. Without one, the default slog logger
+// is used, lowered to debug level when Options.Debug is set.
+func (options *Options) logger() *slog.Logger {
+	if options.Logger != nil {
+		return options.Logger
+	}
+	if options.Debug {
+		return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	}
+	return slog.Default()
+}
+
+// loggingMiddleware logs one event per request attempt: debug level for
+// successful and client-error responses, warning level for server errors and
+// error level for failed requests. Bodies are only logged when
+// Options.Debug is set. Credentials and emails are redacted.
+func loggingMiddleware(logger *slog.Logger, debug bool) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			attrs := []slog.Attr{
+				slog.String("method", requestMethod(req)),
+				slog.String("url", redactURL(req.URL)),
+			}
+			if debug {
+				attrs = append(attrs, slog.Any("headers", redactHeaders(req.Header)))
+				if isJSON(req.Header.Get("Content-Type")) && req.GetBody != nil {
+					if body, err := req.GetBody(); err == nil {
+						b, _ := ioutil.ReadAll(body)
+						attrs = append(attrs, slog.String("request_body", redactBody(b)))
+					}
+				}
+			}
+			if req.ContentLength > 0 {
+				attrs = append(attrs, slog.Int64("request_bytes", req.ContentLength))
+			}
+
+			start := time.Now()
+			resp, err := next(req)
+			attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+				logger.LogAttrs(ctx, slog.LevelError, "wordpress request failed", attrs...)
+				return resp, err
+			}
+
+			body, err := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+			attrs = append(attrs,
+				slog.Int("status", resp.StatusCode),
+				slog.Int("bytes", len(body)),
+			)
+			if debug {
+				attrs = append(attrs, slog.String("response_body", redactBody(body)))
+			}
+			level := slog.LevelDebug
+			if resp.StatusCode >= http.StatusInternalServerError {
+				level = slog.LevelWarn
+			}
+			logger.LogAttrs(ctx, level, "wordpress request", attrs...)
+			return resp, err
+		}
+	}
+}
+
+// requestMethod returns the effective method, taking
+// X-HTTP-Method-Override into account.
+func requestMethod(req *http.Request) string {
+	if override := req.Header.Get("X-HTTP-Method-Override"); override != "" {
+		return override
+	}
+	return req.Method
+}
+
+func redactHeaders(header http.Header) http.Header {
+	h := header.Clone()
+	for _, name := range sensitiveHeaders {
+		if value := h.Get(name); value != "" {
+			h.Set(name, redactCredentials(value))
+		}
+	}
+	return h
+}
+
+// redactCredentials redacts an Authorization value or a basic-auth string,
+// keeping only the authentication scheme.
+func redactCredentials(value string) string {
+	if scheme, _, ok := strings.Cut(value, " "); ok && !strings.Contains(scheme, ":") {
+		switch strings.ToLower(scheme) {
+		case "basic", "bearer", "digest", "negotiate":
+			return scheme + " " + redacted
+		}
+	}
+	if strings.Contains(value, ":") {
+		if redactedValue := appPasswordPattern.ReplaceAllString(value, ":"+redacted); redactedValue != value {
+			return redactedValue
+		}
+	}
+	return redacted
+}
+
+func redactURL(u *url.URL) string {
+	redactedURL := *u
+	redactedURL.User = nil
+	query := u.Query()
+	changed := false
+	for key := range query {
+		if sensitiveKeys[strings.ToLower(key)] {
+			query.Set(key, redacted)
+			changed = true
+		}
+	}
+	if changed {
+		redactedURL.RawQuery = query.Encode()
+	}
+	return redactString(redactedURL.String())
+}
+
+// redactBody removes sensitive values from a JSON body, falling back to
+// pattern matching for anything else.
+func redactBody(body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return redactString(string(body))
+	}
+	b, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return redacted
+	}
+	return string(b)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if sensitiveKeys[strings.ToLower(key)] {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(item)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	case string:
+		return redactString(v)
+	}
+	return value
+}
+
+func redactString(s string) string {
+	s = jwtPattern.ReplaceAllString(s, redacted)
+	s = emailPattern.ReplaceAllString(s, redacted)
+	return s
+}
@@ -1,8 +1,10 @@
 package wordpress
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type MediaCaption struct {
@@ -17,77 +19,182 @@ type MediaDetailsSizesItem struct {
 	File      string `json:"file,omitempty"`
 	Width     int    `json:"width,omitempty"`
 	Height    int    `json:"height,omitempty"`
+	Filesize  int64  `json:"filesize,omitempty"`
 	MimeType  string `json:"mime_type,omitempty"`
 	SourceURL string `json:"source_url,omitempty"`
 }
-type MediaDetailsSizes struct {
-	Thumbnail MediaDetailsSizesItem `json:"thumbnail,omitempty"`
-	Medium    MediaDetailsSizesItem `json:"medium,omitempty"`
-	Large     MediaDetailsSizesItem `json:"large,omitempty"`
-	SiteLogo  MediaDetailsSizesItem `json:"site-logo,omitempty"`
-}
+
+// MediaDetailsSizes are the generated renditions of an image, keyed by size
+// name, e.g. "thumbnail", "medium_large", "1536x1536" or "full".
+type MediaDetailsSizes map[string]MediaDetailsSizesItem
+
 type MediaDetails struct {
 	Raw       string                 `json:"raw,omitempty"`
 	Rendered  string                 `json:"rendered,omitempty"`
 	Width     int                    `json:"width,omitempty"`
 	Height    int                    `json:"height,omitempty"`
 	File      string                 `json:"file,omitempty"`
+	Filesize  int64                  `json:"filesize,omitempty"`
 	Sizes     MediaDetailsSizes      `json:"sizes,omitempty"`
 	ImageMeta map[string]interface{} `json:"image_meta,omitempty"`
-}
-type MediaUploadOptions struct {
-	Filename    string
-	ContentType string
-	Data        []byte
+	// OriginalImage is the file name of the uploaded image when WordPress
+	// scaled it down, the source URL then being the scaled image.
+	OriginalImage string `json:"original_image,omitempty"`
 }
 type Media struct {
-	ID           int              `json:"id,omitempty"`
-	Date         string           `json:"date,omitempty"`
-	DateGMT      string           `json:"date_gmt,omitempty"`
-	GUID         GUID             `json:"guid,omitempty"`
-	Link         string           `json:"link,omitempty"`
-	Modified     string           `json:"modified,omitempty"`
-	ModifiedGMT  string           `json:"modified_gmt,omitempty"`
-	Password     string           `json:"password,omitempty"`
-	Slug         string           `json:"slug,omitempty"`
-	Status       string           `json:"status,omitempty"`
-	Type         string           `json:"type,omitempty"`
-	Title        Title            `json:"title,omitempty"`
-	Author       int              `json:"author,omitempty"`
-	MediaStatus  string           `json:"comment_status,omitempty"`
-	PingStatus   string           `json:"ping_status,omitempty"`
-	AltText      string           `json:"alt_text,omitempty"`
-	Caption      MediaCaption     `json:"caption,omitempty"`
-	Description  MediaDescription `json:"description,omitempty"`
-	MediaType    string           `json:"media_type,omitempty"`
-	MediaDetails MediaDetails     `json:"media_details,omitempty"`
-	Post         int              `json:"post,omitempty"`
-	SourceURL    string           `json:"source_url,omitempty"`
+	collection *MediaCollection `json:"-"`
+
+	ID            int              `json:"id,omitempty"`
+	Date          string           `json:"date,omitempty"`
+	DateGMT       string           `json:"date_gmt,omitempty"`
+	GUID          GUID             `json:"guid,omitempty"`
+	Link          string           `json:"link,omitempty"`
+	Modified      string           `json:"modified,omitempty"`
+	ModifiedGMT   string           `json:"modified_gmt,omitempty"`
+	Password      string           `json:"password,omitempty"`
+	Slug          string           `json:"slug,omitempty"`
+	Status        string           `json:"status,omitempty"`
+	Type          string           `json:"type,omitempty"`
+	Title         Title            `json:"title,omitempty"`
+	Author        int              `json:"author,omitempty"`
+	FeaturedMedia int              `json:"featured_media,omitempty"`
+	MediaStatus   string           `json:"comment_status,omitempty"`
+	PingStatus    string           `json:"ping_status,omitempty"`
+	MetaFields    MetaFields       `json:"meta,omitempty"`
+	Template      string           `json:"template,omitempty"`
+	AltText       string           `json:"alt_text,omitempty"`
+	Caption       MediaCaption     `json:"caption,omitempty"`
+	Description   MediaDescription `json:"description,omitempty"`
+	MediaType     string           `json:"media_type,omitempty"`
+	MimeType      string           `json:"mime_type,omitempty"`
+	MediaDetails  MediaDetails     `json:"media_details,omitempty"`
+	// Post is the ID of the post the media is attached to. Use
+	// MediaCollection.Attach to detach it.
+	Post      int    `json:"post,omitempty"`
+	SourceURL string `json:"source_url,omitempty"`
+	Links     Links  `json:"_links,omitempty"`
+}
+
+func (entity *Media) setCollection(col *MediaCollection) {
+	entity.collection = col
+}
+
+// encodeRaw sends title, caption and description as their raw text, as
+// the rendered HTML is read-only. They are left out when the raw text is
+// empty, e.g. when the media was fetched without `context=edit`, so that
+// updating it does not clear them; use MediaCollection.UpdateFields to clear
+// them. The "inherit" status of attachments is not accepted back by the API
+// and is left out too.
+func (entity *Media) encodeRaw() map[string]interface{} {
+	fields := map[string]interface{}{
+		"title":       rawText(entity.Title.Raw),
+		"caption":     rawText(entity.Caption.Raw),
+		"description": rawText(entity.Description.Raw),
+	}
+	if entity.Status == "inherit" {
+		fields["status"] = nil
+	}
+	return fields
+}
+
+func rawText(raw string) interface{} {
+	if raw == "" {
+		return nil
+	}
+	return raw
+}
+
+func (entity *Media) Populate(params interface{}) (*Media, *http.Response, []byte, error) {
+	return entity.PopulateContext(context.Background(), params)
+}
+func (entity *Media) PopulateContext(ctx context.Context, params interface{}) (*Media, *http.Response, []byte, error) {
+	return entity.collection.GetContext(ctx, entity.ID, params)
 }
+
+// MediaListParams are the query parameters of MediaCollection.List.
+type MediaListParams struct {
+	ListParams
+
+	After          time.Time `url:"after,omitempty"`
+	Before         time.Time `url:"before,omitempty"`
+	ModifiedAfter  time.Time `url:"modified_after,omitempty"`
+	ModifiedBefore time.Time `url:"modified_before,omitempty"`
+	Author         []int     `url:"author,omitempty"`
+	AuthorExclude  []int     `url:"author_exclude,omitempty"`
+	Parent         []int     `url:"parent,omitempty"`
+	ParentExclude  []int     `url:"parent_exclude,omitempty"`
+	Slug           []string  `url:"slug,omitempty"`
+	Status         []string  `url:"status,omitempty"`
+	MediaType      string    `url:"media_type,omitempty"`
+	MimeType       string    `url:"mime_type,omitempty"`
+}
+
 type MediaCollection struct {
-	client *Client
-	url    string
+	*Collection[Media]
 }
 
-func (col *MediaCollection) List(params interface{}) ([]Media, *http.Response, []byte, error) {
-	var media []Media
-	resp, body, err := col.client.List(col.url, params, &media)
-	return media, resp, body, err
+func newMediaCollection(client *Client, url string) *MediaCollection {
+	col := &MediaCollection{}
+	col.Collection = newCollection(client, url, func(entity *Media) { entity.setCollection(col) })
+	return col
 }
+
+func (col *MediaCollection) Entity(id int) *Media {
+	entity := Media{
+		collection: col,
+		ID:         id,
+	}
+	return &entity
+}
+
+// Create uploads a new media file. See MediaUploadOptions for streaming
+// large files.
 func (col *MediaCollection) Create(options *MediaUploadOptions) (*Media, *http.Response, []byte, error) {
+	return col.CreateContext(context.Background(), options)
+}
+func (col *MediaCollection) CreateContext(ctx context.Context, options *MediaUploadOptions) (*Media, *http.Response, []byte, error) {
+	req, err := options.request(ctx, col.url)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 	var created Media
-	resp, body, err := col.client.PostData(col.url, options.Data, options.ContentType, options.Filename, &created)
+	resp, body, err := col.client.do(req, &created)
+	col.setCollection(&created)
 	return &created, resp, body, err
 }
-func (col *MediaCollection) Get(id int, params interface{}) (*Media, *http.Response, []byte, error) {
-	var entity Media
+
+// MediaFields are the text fields of an attachment sent by
+// MediaCollection.UpdateFields. Nil fields are left unchanged, while a
+// pointer to an empty string clears the field.
+type MediaFields struct {
+	Title       *string `json:"title,omitempty"`
+	AltText     *string `json:"alt_text,omitempty"`
+	Caption     *string `json:"caption,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// UpdateFields updates only the fields set in fields of the media id. Unlike
+// Update, it can clear the alt text, caption or description.
+func (col *MediaCollection) UpdateFields(id int, fields *MediaFields) (*Media, *http.Response, []byte, error) {
+	return col.UpdateFieldsContext(context.Background(), id, fields)
+}
+func (col *MediaCollection) UpdateFieldsContext(ctx context.Context, id int, fields *MediaFields) (*Media, *http.Response, []byte, error) {
+	var updated Media
 	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Get(entityURL, params, &entity)
-	return &entity, resp, body, err
+	resp, body, err := col.client.UpdateContext(ctx, entityURL, fields, &updated)
+	col.setCollection(&updated)
+	return &updated, resp, body, err
+}
+
+// Attach attaches the media id to the post postID, or detaches it from its
+// post if postID is 0.
+func (col *MediaCollection) Attach(id int, postID int) (*Media, *http.Response, []byte, error) {
+	return col.AttachContext(context.Background(), id, postID)
 }
-func (col *MediaCollection) Delete(id int, params interface{}) (*Media, *http.Response, []byte, error) {
-	var deleted Media
+func (col *MediaCollection) AttachContext(ctx context.Context, id int, postID int) (*Media, *http.Response, []byte, error) {
+	var updated Media
 	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Delete(entityURL, params, &deleted)
-	return &deleted, resp, body, err
+	resp, body, err := col.client.UpdateContext(ctx, entityURL, map[string]int{"post": postID}, &updated)
+	col.setCollection(&updated)
+	return &updated, resp, body, err
 }
@@ -0,0 +1,106 @@
+package wordpress
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	ImageModifierRotate = "rotate"
+	ImageModifierCrop   = "crop"
+
+	// MediaPostProcessCreateSubsizes regenerates the missing image sub-sizes.
+	MediaPostProcessCreateSubsizes = "create-image-subsizes"
+)
+
+// ImageModifier is an edit applied by MediaCollection.Edit. Use ImageRotate
+// and ImageCrop to build one.
+type ImageModifier struct {
+	Type string             `json:"type"`
+	Args map[string]float64 `json:"args"`
+}
+
+// ImageRotate rotates the image clockwise by angle degrees, a multiple of 90.
+func ImageRotate(angle int) ImageModifier {
+	return ImageModifier{
+		Type: ImageModifierRotate,
+		Args: map[string]float64{"angle": float64(angle)},
+	}
+}
+
+// ImageCrop crops the image. Values are percentages of the image size after
+// the previous modifiers, so the right half is ImageCrop(50, 0, 50, 100).
+// The REST API has no scale modifier: WordPress creates the scaled sizes of
+// the new attachment itself.
+func ImageCrop(left, top, width, height float64) ImageModifier {
+	return ImageModifier{
+		Type: ImageModifierCrop,
+		Args: map[string]float64{"left": left, "top": top, "width": width, "height": height},
+	}
+}
+
+// MediaEditOptions are the parameters of MediaCollection.Edit.
+type MediaEditOptions struct {
+	// Src is the URL of the image being edited, the media source URL or one
+	// of its sizes. If empty, the media source URL is fetched.
+	Src       string          `json:"src"`
+	Modifiers []ImageModifier `json:"modifiers"`
+}
+
+// Edit applies the modifiers to the image id, in order. WordPress saves the
+// result as a new attachment, which is returned; the original is kept.
+// A nil options sends no modifiers, which WordPress rejects with
+// `rest_image_not_edited`.
+func (col *MediaCollection) Edit(id int, options *MediaEditOptions) (*Media, *http.Response, []byte, error) {
+	return col.EditContext(context.Background(), id, options)
+}
+func (col *MediaCollection) EditContext(ctx context.Context, id int, options *MediaEditOptions) (*Media, *http.Response, []byte, error) {
+	var edit MediaEditOptions
+	if options != nil {
+		edit = *options
+	}
+	if edit.Modifiers == nil {
+		edit.Modifiers = []ImageModifier{}
+	}
+	if edit.Src == "" {
+		media, resp, body, err := col.GetContext(ctx, id, nil)
+		if err != nil {
+			return nil, resp, body, err
+		}
+		edit.Src = media.SourceURL
+	}
+	var edited Media
+	entityURL := fmt.Sprintf("%v/%v/edit", col.url, id)
+	resp, body, err := col.client.CreateContext(ctx, entityURL, &edit, &edited)
+	col.setCollection(&edited)
+	return &edited, resp, body, err
+}
+
+// PostProcess runs action, usually MediaPostProcessCreateSubsizes, on the
+// media id. Use it to finish an upload that failed while WordPress was
+// creating the image sub-sizes; see UploadAttachmentID.
+func (col *MediaCollection) PostProcess(id int, action string) (*Media, *http.Response, []byte, error) {
+	return col.PostProcessContext(context.Background(), id, action)
+}
+func (col *MediaCollection) PostProcessContext(ctx context.Context, id int, action string) (*Media, *http.Response, []byte, error) {
+	var processed Media
+	entityURL := fmt.Sprintf("%v/%v/post-process", col.url, id)
+	resp, body, err := col.client.CreateContext(ctx, entityURL, map[string]string{"action": action}, &processed)
+	col.setCollection(&processed)
+	return &processed, resp, body, err
+}
+
+// UploadAttachmentID returns the ID of the attachment created by a failed
+// upload response, from its X-WP-Upload-Attachment-ID header, or 0. WordPress
+// sets it when the file was saved but processing it failed, e.g. on an out of
+// memory error while resizing, so that the upload can be completed with
+// PostProcess instead of being retried.
+func UploadAttachmentID(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+	id, _ := strconv.Atoi(resp.Header.Get("X-WP-Upload-Attachment-ID"))
+	return id
+}
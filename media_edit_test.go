@@ -0,0 +1,101 @@
+package wordpress_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestMediaEdit(t *testing.T) {
+	var sent map[string]interface{}
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/media/7":
+			w.Write([]byte(`{"id":7,"source_url":"https://example.com/hero.jpg"}`))
+		case r.Method == "POST" && r.URL.Path == "/media/7/edit":
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":8,"source_url":"https://example.com/hero-edited.jpg"}`))
+		default:
+			t.Errorf("Unexpected request: %v %v", r.Method, r.URL.Path)
+		}
+	})
+
+	edited, _, _, err := wp.Media().Edit(7, &wordpress.MediaEditOptions{
+		Modifiers: []wordpress.ImageModifier{wordpress.ImageRotate(90), wordpress.ImageCrop(0, 25, 100, 50)},
+	})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if edited.ID != 8 {
+		t.Errorf("Edit should return the new attachment: %+v", edited)
+	}
+	b, _ := json.Marshal(sent)
+	expected := `{"modifiers":[{"args":{"angle":90},"type":"rotate"},{"args":{"height":50,"left":0,"top":25,"width":100},"type":"crop"}],"src":"https://example.com/hero.jpg"}`
+	if string(b) != expected {
+		t.Errorf("Unexpected request:\n%s\nexpected:\n%s", b, expected)
+	}
+}
+
+func TestMediaEdit_NilOptions(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/media/7":
+			w.Write([]byte(`{"id":7,"source_url":"https://example.com/hero.jpg"}`))
+		case r.Method == "POST" && r.URL.Path == "/media/7/edit":
+			var sent map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&sent)
+			if fmt.Sprint(sent["modifiers"]) != "[]" || sent["src"] != "https://example.com/hero.jpg" {
+				t.Errorf("Unexpected request: %v", sent)
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":"rest_image_not_edited","message":"The image was not edited.","data":{"status":400}}`))
+		default:
+			t.Errorf("Unexpected request: %v %v", r.Method, r.URL.Path)
+		}
+	})
+
+	_, _, _, err := wp.Media().Edit(7, nil)
+	if !wordpress.HasErrorCode(err, "rest_image_not_edited") {
+		t.Errorf("Expected rest_image_not_edited error, got %v", err)
+	}
+}
+
+func TestMediaPostProcess_AfterFailedUpload(t *testing.T) {
+	var action string
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/media":
+			w.Header().Set("X-WP-Upload-Attachment-ID", "9")
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"code":"rest_upload_sideload_error","message":"The image sub-sizes could not be created.","data":{"status":500}}`))
+		case "/media/9/post-process":
+			var sent map[string]string
+			json.NewDecoder(r.Body).Decode(&sent)
+			action = sent["action"]
+			w.Write([]byte(`{"id":9,"media_details":{"width":100}}`))
+		}
+	})
+
+	_, resp, _, err := wp.Media().Create(&wordpress.MediaUploadOptions{Filename: "big.jpg", Data: []byte("jpeg")})
+	if err == nil {
+		t.Fatalf("Upload should fail")
+	}
+	id := wordpress.UploadAttachmentID(resp)
+	if id != 9 {
+		t.Fatalf("Unexpected attachment ID: %v", id)
+	}
+	media, _, _, err := wp.Media().PostProcess(id, wordpress.MediaPostProcessCreateSubsizes)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if action != "create-image-subsizes" || media.MediaDetails.Width != 100 {
+		t.Errorf("Unexpected post-process: %v, %+v", action, media)
+	}
+	if wordpress.UploadAttachmentID(nil) != 0 {
+		t.Errorf("Missing response should have no attachment ID")
+	}
+}
@@ -0,0 +1,112 @@
+package wordpress
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// MediaSizeFull is the size name of the full-size image.
+const MediaSizeFull = "full"
+
+// FullSize returns the full-size rendition of the media. Media without a
+// "full" size, such as non-image files, get one built from SourceURL.
+func (entity *Media) FullSize() MediaDetailsSizesItem {
+	if full, ok := entity.MediaDetails.Sizes[MediaSizeFull]; ok && full.SourceURL != "" {
+		return full
+	}
+	return MediaDetailsSizesItem{
+		File:      path.Base(entity.MediaDetails.File),
+		Width:     entity.MediaDetails.Width,
+		Height:    entity.MediaDetails.Height,
+		Filesize:  entity.MediaDetails.Filesize,
+		MimeType:  entity.MimeType,
+		SourceURL: entity.SourceURL,
+	}
+}
+
+// OriginalURL returns the URL of the image as uploaded. It differs from
+// SourceURL when WordPress scaled a big image down on upload.
+func (entity *Media) OriginalURL() string {
+	original := entity.MediaDetails.OriginalImage
+	if original == "" || entity.SourceURL == "" {
+		return entity.SourceURL
+	}
+	return entity.SourceURL[:strings.LastIndex(entity.SourceURL, "/")+1] + original
+}
+
+// Renditions returns the sizes with the aspect ratio of the full-size
+// image, including it, ordered by width. Cropped sizes, such as a square
+// thumbnail of a landscape image, are left out, as WordPress does for srcset.
+func (entity *Media) Renditions() []MediaDetailsSizesItem {
+	full := entity.FullSize()
+	renditions := []MediaDetailsSizesItem{}
+	seen := map[int]bool{}
+	if full.Width > 0 {
+		renditions = append(renditions, full)
+		seen[full.Width] = true
+	}
+	for name, size := range entity.MediaDetails.Sizes {
+		if name == MediaSizeFull || size.Width <= 0 || size.SourceURL == "" || seen[size.Width] {
+			continue
+		}
+		if full.Width > 0 && !matchesRatio(full.Width, full.Height, size.Width, size.Height) {
+			continue
+		}
+		renditions = append(renditions, size)
+		seen[size.Width] = true
+	}
+	sort.Slice(renditions, func(i, j int) bool {
+		return renditions[i].Width < renditions[j].Width
+	})
+	return renditions
+}
+
+// matchesRatio reports whether two sizes have the same aspect ratio, allowing
+// for the rounding of the smaller one.
+func matchesRatio(width1, height1, width2, height2 int) bool {
+	if width1 < width2 {
+		width1, height1, width2, height2 = width2, height2, width1, height1
+	}
+	expected := float64(height1) * float64(width2) / float64(width1)
+	diff := expected - float64(height2)
+	return diff <= 1.5 && diff >= -1.5
+}
+
+// Rendition returns the smallest rendition at least width pixels wide, or
+// the largest one if none is, and false if the media has no sized rendition.
+func (entity *Media) Rendition(width int) (MediaDetailsSizesItem, bool) {
+	renditions := entity.Renditions()
+	if len(renditions) == 0 {
+		return MediaDetailsSizesItem{}, false
+	}
+	for _, rendition := range renditions {
+		if rendition.Width >= width {
+			return rendition, true
+		}
+	}
+	return renditions[len(renditions)-1], true
+}
+
+// Srcset returns the srcset attribute of the image, listing its renditions
+// with their widths, e.g. "https://example.com/a-300x200.jpg 300w, ...".
+func (entity *Media) Srcset() string {
+	candidates := []string{}
+	for _, rendition := range entity.Renditions() {
+		candidates = append(candidates, fmt.Sprintf("%v %vw", rendition.SourceURL, rendition.Width))
+	}
+	return strings.Join(candidates, ", ")
+}
+
+// Sizes returns the sizes attribute of the image displayed width pixels
+// wide, or at its full width if width is 0, matching the WordPress default.
+func (entity *Media) Sizes(width int) string {
+	if width <= 0 {
+		width = entity.FullSize().Width
+	}
+	if width <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("(max-width: %vpx) 100vw, %vpx", width, width)
+}
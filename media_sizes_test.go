@@ -0,0 +1,73 @@
+package wordpress_test
+
+import (
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestFixture_Media(t *testing.T) {
+	wp, fixture := fixtureServerClient(t, "media.json")
+
+	media, _, _, err := wp.Media().Get(7, "context=edit")
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	assertFixtureFields(t, fixture, media, "_links")
+
+	if len(media.MediaDetails.Sizes) != 8 || media.MediaDetails.Sizes["1536x1536"].Width != 1536 {
+		t.Errorf("Every size should be decoded: %v", media.MediaDetails.Sizes)
+	}
+}
+
+func TestMediaSizes_Helpers(t *testing.T) {
+	wp, _ := fixtureServerClient(t, "media.json")
+	media, _, _, err := wp.Media().Get(7, nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+
+	if full := media.FullSize(); full.Width != 2560 || full.SourceURL != media.SourceURL {
+		t.Errorf("Unexpected full size: %+v", full)
+	}
+	if original := media.OriginalURL(); original != "https://example.com/wp-content/uploads/2024/06/hero.jpg" {
+		t.Errorf("Unexpected original URL: %v", original)
+	}
+
+	for width, expected := range map[int]int{0: 300, 300: 300, 301: 768, 1000: 1024, 2000: 2048, 4000: 2560} {
+		rendition, ok := media.Rendition(width)
+		if !ok || rendition.Width != expected {
+			t.Errorf("Rendition for %v: expected %v, got %+v", width, expected, rendition)
+		}
+	}
+
+	srcset := "https://example.com/wp-content/uploads/2024/06/hero-300x200.jpg 300w, " +
+		"https://example.com/wp-content/uploads/2024/06/hero-768x512.jpg 768w, " +
+		"https://example.com/wp-content/uploads/2024/06/hero-1024x683.jpg 1024w, " +
+		"https://example.com/wp-content/uploads/2024/06/hero-1536x1024.jpg 1536w, " +
+		"https://example.com/wp-content/uploads/2024/06/hero-2048x1365.jpg 2048w, " +
+		"https://example.com/wp-content/uploads/2024/06/hero-scaled.jpg 2560w"
+	if media.Srcset() != srcset {
+		t.Errorf("Cropped sizes should be left out of srcset: %v", media.Srcset())
+	}
+	if sizes := media.Sizes(1024); sizes != "(max-width: 1024px) 100vw, 1024px" {
+		t.Errorf("Unexpected sizes: %v", sizes)
+	}
+	if sizes := media.Sizes(0); sizes != "(max-width: 2560px) 100vw, 2560px" {
+		t.Errorf("Unexpected sizes: %v", sizes)
+	}
+}
+
+func TestMediaSizes_NonImage(t *testing.T) {
+	media := wordpress.Media{SourceURL: "https://example.com/doc.pdf", MimeType: "application/pdf"}
+
+	if full := media.FullSize(); full.SourceURL != media.SourceURL || full.MimeType != "application/pdf" {
+		t.Errorf("Unexpected full size: %+v", full)
+	}
+	if _, ok := media.Rendition(300); ok {
+		t.Errorf("Media without sizes should have no rendition")
+	}
+	if media.Srcset() != "" || media.Sizes(0) != "" || media.OriginalURL() != media.SourceURL {
+		t.Errorf("Unexpected attributes: %q, %q", media.Srcset(), media.Sizes(0))
+	}
+}
@@ -0,0 +1,98 @@
+package wordpress_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestMediaUpdate(t *testing.T) {
+	var sent map[string]interface{}
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/media/7" {
+			t.Errorf("Unexpected path: %v", r.URL.Path)
+		}
+		if r.Method == "POST" {
+			if r.Header.Get("X-HTTP-Method-Override") != "PUT" {
+				t.Errorf("Update should be sent as PUT")
+			}
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.Write([]byte(`{"id":7,"status":"inherit","alt_text":"A cat","post":12,"caption":{"raw":"Cat","rendered":"<p>Cat</p>\n"}}`))
+			return
+		}
+		w.Write([]byte(`{"id":7,"status":"inherit","title":{"rendered":"cat"},"caption":{"rendered":"<p>Old</p>\n"},"description":{"raw":"Desc","rendered":"<p>Desc</p>\n"},"media_details":{"width":10}}`))
+	})
+
+	media, _, _, err := wp.Media().Entity(7).Populate(nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	media.AltText = "A cat"
+	media.Caption.Raw = "Cat"
+	media.Post = 12
+
+	updated, _, _, err := wp.Media().Update(media.ID, media)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if sent["alt_text"] != "A cat" || sent["caption"] != "Cat" || sent["description"] != "Desc" || sent["post"] != float64(12) {
+		t.Errorf("Unexpected request: %v", sent)
+	}
+	if _, ok := sent["title"]; ok {
+		t.Errorf("Title without raw text should not be sent: %v", sent["title"])
+	}
+	if _, ok := sent["status"]; ok {
+		t.Errorf("Inherit status should not be sent: %v", sent["status"])
+	}
+	if updated.Caption.Rendered != "<p>Cat</p>\n" || updated.Post != 12 {
+		t.Errorf("Unexpected updated media: %+v", updated)
+	}
+	if _, _, _, err := updated.Populate(nil); err != nil {
+		t.Errorf("Updated media should be bound to its collection: %v", err)
+	}
+}
+
+func TestMediaAttach_Detach(t *testing.T) {
+	var sent map[string]interface{}
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Write([]byte(`{"id":7,"post":null}`))
+	})
+
+	media, _, _, err := wp.Media().Attach(7, 0)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if fmt.Sprint(sent) != "map[post:0]" {
+		t.Errorf("Detaching should send post 0: %v", sent)
+	}
+	if media.Post != 0 {
+		t.Errorf("Unexpected post: %v", media.Post)
+	}
+}
+
+func TestMediaUpdateFields_Clear(t *testing.T) {
+	var sent map[string]interface{}
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-HTTP-Method-Override") != "PUT" || r.URL.Path != "/media/7" {
+			t.Errorf("Unexpected request: %v %v", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Write([]byte(`{"id":7,"alt_text":"","caption":{"raw":"","rendered":""}}`))
+	})
+
+	empty := ""
+	media, _, _, err := wp.Media().UpdateFields(7, &wordpress.MediaFields{AltText: &empty, Caption: &empty})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if fmt.Sprint(sent) != "map[alt_text: caption:]" {
+		t.Errorf("Cleared fields should be sent as empty strings: %v", sent)
+	}
+	if media.ID != 7 || media.AltText != "" {
+		t.Errorf("Unexpected media: %+v", media)
+	}
+}
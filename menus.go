@@ -0,0 +1,243 @@
+package wordpress
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"slices"
+	"sort"
+)
+
+const (
+	MenuItemTypeTaxonomy        = "taxonomy"
+	MenuItemTypePostType        = "post_type"
+	MenuItemTypePostTypeArchive = "post_type_archive"
+	MenuItemTypeCustom          = "custom"
+)
+
+// Menu is a navigation menu. Menus, their items and locations are only
+// available to users with the edit_theme_options capability.
+type Menu struct {
+	ID          int        `json:"id,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Slug        string     `json:"slug,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Meta        MetaFields `json:"meta,omitempty"`
+	// Locations are the theme locations the menu is assigned to. Use
+	// MenusCollection.AssignLocation to change them.
+	Locations []string `json:"locations,omitempty"`
+	AutoAdd   bool     `json:"auto_add,omitempty"`
+	Links     Links    `json:"_links,omitempty"`
+}
+
+// MenusCollection is the `/menus` collection. Menus cannot be trashed, delete
+// them with ForceDelete.
+type MenusCollection struct {
+	*Collection[Menu]
+}
+
+// AssignLocation assigns the menu id to the theme location, keeping its
+// other locations. The menu previously in location is unassigned from it.
+func (col *MenusCollection) AssignLocation(id int, location string) (*Menu, *http.Response, []byte, error) {
+	return col.AssignLocationContext(context.Background(), id, location)
+}
+func (col *MenusCollection) AssignLocationContext(ctx context.Context, id int, location string) (*Menu, *http.Response, []byte, error) {
+	return col.updateLocations(ctx, id, func(locations []string) []string {
+		if slices.Contains(locations, location) {
+			return locations
+		}
+		return append(locations, location)
+	})
+}
+
+// UnassignLocation removes the theme location from the menu id.
+func (col *MenusCollection) UnassignLocation(id int, location string) (*Menu, *http.Response, []byte, error) {
+	return col.UnassignLocationContext(context.Background(), id, location)
+}
+func (col *MenusCollection) UnassignLocationContext(ctx context.Context, id int, location string) (*Menu, *http.Response, []byte, error) {
+	return col.updateLocations(ctx, id, func(locations []string) []string {
+		return slices.DeleteFunc(locations, func(l string) bool { return l == location })
+	})
+}
+
+func (col *MenusCollection) updateLocations(ctx context.Context, id int, update func([]string) []string) (*Menu, *http.Response, []byte, error) {
+	menu, resp, body, err := col.GetContext(ctx, id, nil)
+	if err != nil {
+		return nil, resp, body, err
+	}
+	locations := update(append([]string{}, menu.Locations...))
+
+	var updated Menu
+	entityURL := fmt.Sprintf("%v/%v", col.url, id)
+	resp, body, err = col.client.UpdateContext(ctx, entityURL, map[string][]string{"locations": locations}, &updated)
+	return &updated, resp, body, err
+}
+
+// MenuItem is an item of a navigation menu, linking to a post or term
+// (Object and ObjectID), a post type archive or a custom URL.
+type MenuItem struct {
+	ID    int          `json:"id,omitempty"`
+	Title RenderedText `json:"title,omitempty"`
+	// Type is one of the MenuItemType constants.
+	Type      string `json:"type,omitempty"`
+	TypeLabel string `json:"type_label,omitempty"`
+	Status    string `json:"status,omitempty"`
+	// Object is the post type or taxonomy of the linked entity, or "custom".
+	Object      string `json:"object,omitempty"`
+	ObjectID    int    `json:"object_id,omitempty"`
+	Parent      int    `json:"parent,omitempty"`
+	MenuOrder   int    `json:"menu_order,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Target      string `json:"target,omitempty"`
+	AttrTitle   string `json:"attr_title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Classes are CSS classes.
+	Classes []string   `json:"classes,omitempty"`
+	XFN     []string   `json:"xfn,omitempty"`
+	Invalid bool       `json:"invalid,omitempty"`
+	Meta    MetaFields `json:"meta,omitempty"`
+	// Menu is the ID of the menu holding the item.
+	Menu  int   `json:"menus,omitempty"`
+	Links Links `json:"_links,omitempty"`
+}
+
+// MenuItemListParams are the query parameters of MenuItemsCollection.List.
+type MenuItemListParams struct {
+	ListParams
+
+	Menus        []int    `url:"menus,omitempty"`
+	MenusExclude []int    `url:"menus_exclude,omitempty"`
+	MenuOrder    int      `url:"menu_order,omitempty"`
+	Status       []string `url:"status,omitempty"`
+}
+
+// MenuItemsCollection is the `/menu-items` collection. Menu items cannot be
+// trashed, delete them with ForceDelete.
+type MenuItemsCollection struct {
+	*Collection[MenuItem]
+}
+
+// Tree fetches all items of the menu menuID and assembles them with
+// BuildMenuTree.
+func (col *MenuItemsCollection) Tree(menuID int) ([]*MenuNode, error) {
+	return col.TreeContext(context.Background(), menuID)
+}
+func (col *MenuItemsCollection) TreeContext(ctx context.Context, menuID int) ([]*MenuNode, error) {
+	var items []MenuItem
+	params := &MenuItemListParams{ListParams: ListParams{PerPage: 100}, Menus: []int{menuID}}
+	for item, err := range col.All(ctx, params) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return BuildMenuTree(items), nil
+}
+
+// MenuNode is a menu item with its sub-items.
+type MenuNode struct {
+	MenuItem
+	Children []*MenuNode
+}
+
+// Walk returns an iterator over the nodes of the tree in display order, each
+// with its depth, 0 for top-level items.
+func (node *MenuNode) Walk() iter.Seq2[*MenuNode, int] {
+	return func(yield func(*MenuNode, int) bool) {
+		node.walk(0, yield)
+	}
+}
+
+func (node *MenuNode) walk(depth int, yield func(*MenuNode, int) bool) bool {
+	if !yield(node, depth) {
+		return false
+	}
+	for _, child := range node.Children {
+		if !child.walk(depth+1, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// BuildMenuTree assembles the flat items of a menu into a tree, each level
+// ordered by MenuOrder. Items whose parent is missing, or whose parents form
+// a cycle, are kept at the top level.
+func BuildMenuTree(items []MenuItem) []*MenuNode {
+	nodes := make(map[int]*MenuNode, len(items))
+	for _, item := range items {
+		nodes[item.ID] = &MenuNode{MenuItem: item}
+	}
+
+	// inCycle reports whether the parents of item lead back to it
+	inCycle := func(item MenuItem) bool {
+		seen := map[int]bool{}
+		for parent, ok := nodes[item.Parent]; ok && !seen[parent.ID]; parent, ok = nodes[parent.Parent] {
+			if parent.ID == item.ID {
+				return true
+			}
+			seen[parent.ID] = true
+		}
+		return false
+	}
+
+	var roots []*MenuNode
+	for _, item := range items {
+		node := nodes[item.ID]
+		parent, ok := nodes[item.Parent]
+		if !ok || inCycle(item) {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	var sortNodes func(nodes []*MenuNode)
+	sortNodes = func(nodes []*MenuNode) {
+		sort.SliceStable(nodes, func(i, j int) bool {
+			return nodes[i].MenuOrder < nodes[j].MenuOrder
+		})
+		for _, node := range nodes {
+			sortNodes(node.Children)
+		}
+	}
+	sortNodes(roots)
+	return roots
+}
+
+// MenuLocation is a theme location menus can be assigned to.
+type MenuLocation struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Menu is the ID of the menu assigned to the location, 0 if none.
+	Menu  int   `json:"menu,omitempty"`
+	Links Links `json:"_links,omitempty"`
+}
+
+// MenuLocationsCollection is the `/menu-locations` collection of the
+// locations registered by the active theme.
+type MenuLocationsCollection struct {
+	client *Client
+	url    string
+}
+
+// List returns the menu locations, keyed by name.
+func (col *MenuLocationsCollection) List(params interface{}) (map[string]MenuLocation, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *MenuLocationsCollection) ListContext(ctx context.Context, params interface{}) (map[string]MenuLocation, *http.Response, []byte, error) {
+	var locations map[string]MenuLocation
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &locations)
+	return locations, resp, body, err
+}
+
+func (col *MenuLocationsCollection) Get(location string, params interface{}) (*MenuLocation, *http.Response, []byte, error) {
+	return col.GetContext(context.Background(), location, params)
+}
+func (col *MenuLocationsCollection) GetContext(ctx context.Context, location string, params interface{}) (*MenuLocation, *http.Response, []byte, error) {
+	var entity MenuLocation
+	entityURL := fmt.Sprintf("%v/%v", col.url, escapePath(location))
+	resp, body, err := col.client.GetContext(ctx, entityURL, params, &entity)
+	return &entity, resp, body, err
+}
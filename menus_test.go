@@ -0,0 +1,133 @@
+package wordpress_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestBuildMenuTree(t *testing.T) {
+	items := []wordpress.MenuItem{
+		{ID: 4, Parent: 1, MenuOrder: 3, Title: wordpress.RenderedText{Rendered: "Team"}},
+		{ID: 1, MenuOrder: 2, Title: wordpress.RenderedText{Rendered: "About"}},
+		{ID: 2, MenuOrder: 1, Title: wordpress.RenderedText{Rendered: "Home"}},
+		{ID: 3, Parent: 1, MenuOrder: 4, Title: wordpress.RenderedText{Rendered: "History"}},
+		{ID: 5, Parent: 4, MenuOrder: 5, Title: wordpress.RenderedText{Rendered: "Jobs"}},
+		{ID: 6, Parent: 99, MenuOrder: 6, Title: wordpress.RenderedText{Rendered: "Orphan"}},
+		{ID: 7, Parent: 8, MenuOrder: 7, Title: wordpress.RenderedText{Rendered: "Loop A"}},
+		{ID: 8, Parent: 7, MenuOrder: 8, Title: wordpress.RenderedText{Rendered: "Loop B"}},
+	}
+
+	var lines []string
+	for _, root := range wordpress.BuildMenuTree(items) {
+		for node, depth := range root.Walk() {
+			lines = append(lines, strings.Repeat("-", depth)+node.Title.Rendered)
+		}
+	}
+	expected := "Home,About,-Team,--Jobs,-History,Orphan,Loop A,Loop B"
+	if got := strings.Join(lines, ","); got != expected {
+		t.Errorf("Unexpected tree: %v, expected %v", got, expected)
+	}
+}
+
+func TestMenus(t *testing.T) {
+	var sent map[string]interface{}
+	wp := initTestRoutesClient(t, map[string]http.HandlerFunc{
+		"GET /menu-locations": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"primary":{"name":"primary","description":"Primary menu","menu":3},"footer":{"name":"footer","description":"Footer menu","menu":0}}`))
+		},
+		"GET /menu-locations/primary": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"name":"primary","description":"Primary menu","menu":3}`))
+		},
+		"GET /menus/3": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"id":3,"name":"Main","locations":["primary"]}`))
+		},
+		"PUT /menus/3": func(w http.ResponseWriter, r *http.Request) {
+			sent = nil
+			json.NewDecoder(r.Body).Decode(&sent)
+			b, _ := json.Marshal(sent["locations"])
+			w.Write([]byte(`{"id":3,"name":"Main","locations":` + string(b) + `}`))
+		},
+		"DELETE /menus/3": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("force") != "true" {
+				t.Errorf("Menus should be deleted with force=true")
+			}
+			w.Write([]byte(`{"deleted":true,"previous":{"id":3,"name":"Main"}}`))
+		},
+		"GET /menu-items": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("menus") != "3" {
+				t.Errorf("Unexpected query: %v", r.URL.RawQuery)
+			}
+			w.Write([]byte(`[{"id":10,"title":{"rendered":"Home"},"menu_order":1,"menus":3},{"id":11,"title":{"rendered":"Blog"},"parent":10,"menu_order":2,"menus":3}]`))
+		},
+		"POST /menu-items": func(w http.ResponseWriter, r *http.Request) {
+			sent = nil
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":12,"type":"custom","object":"custom","url":"https://example.org","target":"_blank","classes":["external"],"menus":3,"parent":10,"menu_order":3}`))
+		},
+		"DELETE /menu-items/12": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("force") != "true" {
+				t.Errorf("Menu items should be deleted with force=true")
+			}
+			w.Write([]byte(`{"deleted":true,"previous":{"id":12}}`))
+		},
+	})
+
+	locations, _, _, err := wp.MenuLocations().List(nil)
+	if err != nil || locations["primary"].Menu != 3 || locations["footer"].Menu != 0 {
+		t.Errorf("Unexpected locations: %+v, %v", locations, err)
+	}
+	primary, _, _, err := wp.MenuLocations().Get("primary", nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+
+	tree, err := wp.MenuItems().Tree(primary.Menu)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if len(tree) != 1 || len(tree[0].Children) != 1 || tree[0].Children[0].Title.Rendered != "Blog" {
+		t.Errorf("Unexpected tree: %+v", tree)
+	}
+
+	item, _, _, err := wp.MenuItems().Create(&wordpress.MenuItem{
+		Title:     wordpress.RenderedText{Raw: "Partner"},
+		Type:      wordpress.MenuItemTypeCustom,
+		Object:    "custom",
+		URL:       "https://example.org",
+		Target:    "_blank",
+		Classes:   []string{"external"},
+		Parent:    10,
+		MenuOrder: 3,
+		Menu:      3,
+	})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if fmt.Sprintf("%v %v %v %v", sent["menus"], sent["parent"], sent["classes"], sent["target"]) != "3 10 [external] _blank" {
+		t.Errorf("Unexpected request: %v", sent)
+	}
+	if item.ID != 12 || item.Classes[0] != "external" {
+		t.Errorf("Unexpected item: %+v", item)
+	}
+	if deleted, _, _, err := wp.MenuItems().ForceDelete(12); err != nil || deleted.ID != 12 {
+		t.Errorf("Unexpected deleted item: %+v, %v", deleted, err)
+	}
+
+	menu, _, _, err := wp.Menus().AssignLocation(3, "footer")
+	if err != nil || fmt.Sprint(menu.Locations) != "[primary footer]" {
+		t.Errorf("Unexpected menu: %+v, %v", menu, err)
+	}
+	menu, _, _, err = wp.Menus().UnassignLocation(3, "primary")
+	if err != nil || fmt.Sprint(sent["locations"]) != "[]" || len(menu.Locations) != 0 {
+		t.Errorf("Unexpected menu: %+v, %v, %v", menu, sent, err)
+	}
+	if deleted, _, _, err := wp.Menus().ForceDelete(3); err != nil || deleted.Name != "Main" {
+		t.Errorf("Unexpected deleted menu: %+v, %v", deleted, err)
+	}
+}
@@ -1,8 +1,8 @@
 package wordpress
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"net/http"
 )
 
@@ -24,31 +24,45 @@ type MetaCollection struct {
 }
 
 func (col *MetaCollection) List(params interface{}) ([]Meta, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *MetaCollection) ListContext(ctx context.Context, params interface{}) ([]Meta, *http.Response, []byte, error) {
 	var meta []Meta
-	resp, body, err := col.client.List(col.url, params, &meta)
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &meta)
 	return meta, resp, body, err
 }
 func (col *MetaCollection) Create(new *Meta) (*Meta, *http.Response, []byte, error) {
+	return col.CreateContext(context.Background(), new)
+}
+func (col *MetaCollection) CreateContext(ctx context.Context, new *Meta) (*Meta, *http.Response, []byte, error) {
 	var created Meta
-	resp, body, err := col.client.Create(col.url, new, &created)
+	resp, body, err := col.client.CreateContext(ctx, col.url, new, &created)
 	return &created, resp, body, err
 }
 func (col *MetaCollection) Get(id int, params interface{}) (*Meta, *http.Response, []byte, error) {
+	return col.GetContext(context.Background(), id, params)
+}
+func (col *MetaCollection) GetContext(ctx context.Context, id int, params interface{}) (*Meta, *http.Response, []byte, error) {
 	var meta Meta
 	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Get(entityURL, params, &meta)
+	resp, body, err := col.client.GetContext(ctx, entityURL, params, &meta)
 	return &meta, resp, body, err
 }
 func (col *MetaCollection) Update(id int, meta *Meta) (*Meta, *http.Response, []byte, error) {
+	return col.UpdateContext(context.Background(), id, meta)
+}
+func (col *MetaCollection) UpdateContext(ctx context.Context, id int, meta *Meta) (*Meta, *http.Response, []byte, error) {
 	var updated Meta
 	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	log.Println("URL", entityURL)
-	resp, body, err := col.client.Update(entityURL, meta, &updated)
+	resp, body, err := col.client.UpdateContext(ctx, entityURL, meta, &updated)
 	return &updated, resp, body, err
 }
 func (col *MetaCollection) Delete(id int, params interface{}) (*MetaDeletedResponse, *http.Response, []byte, error) {
+	return col.DeleteContext(context.Background(), id, params)
+}
+func (col *MetaCollection) DeleteContext(ctx context.Context, id int, params interface{}) (*MetaDeletedResponse, *http.Response, []byte, error) {
 	var response MetaDeletedResponse
 	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Delete(entityURL, params, &response)
+	resp, body, err := col.client.DeleteContext(ctx, entityURL, params, &response)
 	return &response, resp, body, err
 }
@@ -0,0 +1,72 @@
+package wordpress
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Handler sends a request and returns its response.
+type Handler func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a Handler to inspect or modify requests before they are
+// sent and responses after they are received.
+//
+// Every request made by Client goes through the chain, once per attempt when
+// retries are enabled. Auth is applied first, then the middlewares run in the
+// order they were registered, then request logging, then the transport.
+type Middleware func(next Handler) Handler
+
+// BeforeSend returns a Middleware that calls fn on every outgoing request.
+// A non-nil error aborts the request.
+func BeforeSend(fn func(req *http.Request) error) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			if err := fn(req); err != nil {
+				return nil, err
+			}
+			return next(req)
+		}
+	}
+}
+
+// AfterReceive returns a Middleware that calls fn on every response received.
+// A non-nil error is returned to the caller along with the response, whose
+// body is still read and closed by the client.
+func AfterReceive(fn func(resp *http.Response) error) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			if err != nil {
+				return resp, err
+			}
+			return resp, fn(resp)
+		}
+	}
+}
+
+// Use appends middlewares to the client's chain. It must not be called
+// concurrently with requests.
+func (client *Client) Use(middlewares ...Middleware) {
+	client.middlewares = append(client.middlewares, middlewares...)
+	client.handler = client.buildHandler()
+}
+
+func (client *Client) buildHandler() Handler {
+	handler := loggingMiddleware(client.logger, client.options.Debug)(client.httpClient.Do)
+	for i := len(client.middlewares) - 1; i >= 0; i-- {
+		handler = client.middlewares[i](handler)
+	}
+	return authMiddleware(client.options)(handler)
+}
+
+// authMiddleware applies the configured credentials to every request.
+func authMiddleware(options *Options) Middleware {
+	return BeforeSend(func(req *http.Request) error {
+		options.applyAuth(req)
+		return nil
+	})
+}
+
+func isJSON(contentType string) bool {
+	return strings.HasPrefix(contentType, "application/json")
+}
@@ -0,0 +1,123 @@
+package wordpress_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestMiddleware_Order(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Signature") != "Basic dXNlcjpwYXNzd29yZA==|first|second" {
+			t.Errorf("Unexpected request signature: %v", r.Header.Get("X-Signature"))
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	var order []string
+	sign := func(name string) wordpress.Middleware {
+		return func(next wordpress.Handler) wordpress.Handler {
+			return func(req *http.Request) (*http.Response, error) {
+				signature := req.Header.Get("X-Signature")
+				if signature == "" {
+					// auth is applied before any middleware runs
+					signature = req.Header.Get("Authorization")
+				}
+				req.Header.Set("X-Signature", signature+"|"+name)
+				order = append(order, "before "+name)
+				resp, err := next(req)
+				order = append(order, "after "+name)
+				return resp, err
+			}
+		}
+	}
+
+	wp := wordpress.NewClient(&wordpress.Options{
+		BaseAPIURL:  server.URL,
+		Username:    "user",
+		Password:    "password",
+		Middlewares: []wordpress.Middleware{sign("first")},
+	})
+	wp.Use(sign("second"))
+
+	if _, _, _, err := wp.Posts().List(nil); err != nil {
+		t.Errorf("Should not return error: %v", err.Error())
+	}
+	expected := "before first,before second,after second,after first"
+	if strings.Join(order, ",") != expected {
+		t.Errorf("Unexpected middleware order: %v", order)
+	}
+}
+
+func TestMiddleware_BeforeSendAbort(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Request should not reach the server")
+	})
+	errBlocked := errors.New("blocked")
+	wp.Use(wordpress.BeforeSend(func(req *http.Request) error {
+		return errBlocked
+	}))
+
+	_, _, _, err := wp.Posts().Get(1, nil)
+	if !errors.Is(err, errBlocked) {
+		t.Errorf("Expected BeforeSend error, got %v", err)
+	}
+}
+
+func TestMiddleware_AfterReceiveMutatesResponse(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":1}`))
+	})
+	wp.Use(wordpress.AfterReceive(func(resp *http.Response) error {
+		resp.Body.Close()
+		resp.Body = io.NopCloser(strings.NewReader(`{"id":2}`))
+		return nil
+	}))
+
+	post, _, _, err := wp.Posts().Get(1, nil)
+	if err != nil {
+		t.Errorf("Should not return error: %v", err.Error())
+	}
+	if post.ID != 2 {
+		t.Errorf("Expected mutated response, got post %v", post.ID)
+	}
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (body *closeRecorder) Close() error {
+	body.closed = true
+	return nil
+}
+
+func TestMiddleware_AfterReceiveErrorClosesBody(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":1}`))
+	})
+	var body *closeRecorder
+	errRejected := errors.New("rejected")
+	wp.Use(wordpress.AfterReceive(func(resp *http.Response) error {
+		return errRejected
+	}), wordpress.AfterReceive(func(resp *http.Response) error {
+		body = &closeRecorder{Reader: resp.Body}
+		resp.Body = body
+		return nil
+	}))
+
+	_, _, _, err := wp.Posts().Get(1, nil)
+	if !errors.Is(err, errRejected) {
+		t.Errorf("Expected AfterReceive error, got %v", err)
+	}
+	if body == nil || !body.closed {
+		t.Errorf("Response body should be closed")
+	}
+}
@@ -1,43 +1,58 @@
 package wordpress
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type Page struct {
 	collection *PagesCollection `json:"-"`
 
-	ID            int     `json:"id,omitempty"`
-	Date          string  `json:"date,omitempty"`
-	DateGMT       string  `json:"date_gmt,omitempty"`
-	GUID          GUID    `json:"guid,omitempty"`
-	Link          string  `json:"link,omitempty"`
-	Modified      string  `json:"modified,omitempty"`
-	ModifiedGMT   string  `json:"modified_gmt,omitempty"`
-	Password      string  `json:"password,omitempty"`
-	Slug          string  `json:"slug,omitempty"`
-	Status        string  `json:"status,omitempty"`
-	Type          string  `json:"type,omitempty"`
-	Parent        int     `json:"parent,omitempty"`
-	Title         Title   `json:"title,omitempty"`
-	Content       Content `json:"content,omitempty"`
-	Author        int     `json:"author,omitempty"`
-	Excerpt       Excerpt `json:"excerpt,omitempty"`
-	FeaturedMedia int     `json:"featured_media,omitempty"`
-	CommentStatus string  `json:"comment_status,omitempty"`
-	PingStatus    string  `json:"ping_status,omitempty"`
-	MenuOrder     int     `json:"menu_order,omitempty"`
-	Template      string  `json:"template,omitempty"`
+	ID                int        `json:"id,omitempty"`
+	Date              string     `json:"date,omitempty"`
+	DateGMT           string     `json:"date_gmt,omitempty"`
+	GUID              GUID       `json:"guid,omitempty"`
+	Link              string     `json:"link,omitempty"`
+	Modified          string     `json:"modified,omitempty"`
+	ModifiedGMT       string     `json:"modified_gmt,omitempty"`
+	Password          string     `json:"password,omitempty"`
+	Slug              string     `json:"slug,omitempty"`
+	Status            string     `json:"status,omitempty"`
+	Type              string     `json:"type,omitempty"`
+	PermalinkTemplate string     `json:"permalink_template,omitempty"`
+	GeneratedSlug     string     `json:"generated_slug,omitempty"`
+	Parent            int        `json:"parent,omitempty"`
+	Title             Title      `json:"title,omitempty"`
+	Content           Content    `json:"content,omitempty"`
+	Author            int        `json:"author,omitempty"`
+	Excerpt           Excerpt    `json:"excerpt,omitempty"`
+	FeaturedMedia     int        `json:"featured_media,omitempty"`
+	CommentStatus     string     `json:"comment_status,omitempty"`
+	PingStatus        string     `json:"ping_status,omitempty"`
+	MenuOrder         int        `json:"menu_order,omitempty"`
+	MetaFields        MetaFields `json:"meta,omitempty"`
+	Template          string     `json:"template,omitempty"`
+	ClassList         ClassList  `json:"class_list,omitempty"`
+	Links             Links      `json:"_links,omitempty"`
+	// TaxonomyTerms holds the term IDs of taxonomies registered for pages,
+	// keyed by the taxonomy's rest_base. See Post.TaxonomyTerms.
+	TaxonomyTerms TaxonomyTerms `json:"-"`
 }
 
 func (entity *Page) setCollection(col *PagesCollection) {
 	entity.collection = col
 }
+func (entity *Page) decodeRaw(raw []byte, known map[string]bool) {
+	entity.TaxonomyTerms = decodeTaxonomyTerms(raw, known)
+}
+func (entity *Page) encodeRaw() map[string]interface{} {
+	return entity.TaxonomyTerms.fields()
+}
 func (entity *Page) Meta() *MetaCollection {
 	if entity.collection == nil {
 		// missing page.collection parent. Probably Page struct was initialized manually.
-		_warning("Missing parent page collection")
 		return nil
 	}
 	return &MetaCollection{
@@ -50,7 +65,6 @@ func (entity *Page) Meta() *MetaCollection {
 func (entity *Page) Revisions() *RevisionsCollection {
 	if entity.collection == nil {
 		// missing page.collection parent. Probably Page struct was initialized manually, not fetched from API
-		_warning("Missing parent page collection")
 		return nil
 	}
 	return &RevisionsCollection{
@@ -60,46 +74,54 @@ func (entity *Page) Revisions() *RevisionsCollection {
 		url:        fmt.Sprintf("%v/%v/%v", entity.collection.url, entity.ID, CollectionRevisions),
 	}
 }
+func (entity *Page) Autosaves() *AutosavesCollection {
+	if entity.collection == nil {
+		// missing page.collection parent. Probably Page struct was initialized manually, not fetched from API
+		return nil
+	}
+	return &AutosavesCollection{
+		client:     entity.collection.client,
+		parent:     entity,
+		parentType: CollectionPages,
+		url:        fmt.Sprintf("%v/%v/%v", entity.collection.url, entity.ID, CollectionAutosaves),
+	}
+}
 
 func (entity *Page) Populate(params interface{}) (*Page, *http.Response, []byte, error) {
-	return entity.collection.Get(entity.ID, params)
+	return entity.PopulateContext(context.Background(), params)
 }
-
-type PagesCollection struct {
-	client    *Client
-	url       string
-	entityURL string
+func (entity *Page) PopulateContext(ctx context.Context, params interface{}) (*Page, *http.Response, []byte, error) {
+	return entity.collection.GetContext(ctx, entity.ID, params)
 }
 
-func (col *PagesCollection) List(params interface{}) ([]Page, *http.Response, []byte, error) {
-	var pages []Page
-	resp, body, err := col.client.List(col.url, params, &pages)
-
-	// set collection object for each entity which has sub-collection
-	for i := range pages {
-		pages[i].setCollection(col)
-	}
-
-	return pages, resp, body, err
+// PageListParams are the query parameters of PagesCollection.List.
+type PageListParams struct {
+	ListParams
+
+	After          time.Time `url:"after,omitempty"`
+	Before         time.Time `url:"before,omitempty"`
+	ModifiedAfter  time.Time `url:"modified_after,omitempty"`
+	ModifiedBefore time.Time `url:"modified_before,omitempty"`
+	Author         []int     `url:"author,omitempty"`
+	AuthorExclude  []int     `url:"author_exclude,omitempty"`
+	MenuOrder      *int      `url:"menu_order,omitempty"`
+	Parent         []int     `url:"parent,omitempty"`
+	ParentExclude  []int     `url:"parent_exclude,omitempty"`
+	Slug           []string  `url:"slug,omitempty"`
+	Status         []string  `url:"status,omitempty"`
+	SearchColumns  []string  `url:"search_columns,omitempty"`
 }
-func (col *PagesCollection) Create(new *Page) (*Page, *http.Response, []byte, error) {
-	var created Page
-	resp, body, err := col.client.Create(col.url, new, &created)
 
-	created.setCollection(col)
-
-	return &created, resp, body, err
+type PagesCollection struct {
+	*Collection[Page]
 }
-func (col *PagesCollection) Get(id int, params interface{}) (*Page, *http.Response, []byte, error) {
-	var entity Page
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Get(entityURL, params, &entity)
 
-	// set collection object for each entity which has sub-collection
-	entity.setCollection(col)
-
-	return &entity, resp, body, err
+func newPagesCollection(client *Client, url string) *PagesCollection {
+	col := &PagesCollection{}
+	col.Collection = newCollection(client, url, func(entity *Page) { entity.setCollection(col) })
+	return col
 }
+
 func (col *PagesCollection) Entity(id int) *Page {
 	entity := Page{
 		collection: col,
@@ -107,25 +129,3 @@ func (col *PagesCollection) Entity(id int) *Page {
 	}
 	return &entity
 }
-
-func (col *PagesCollection) Update(id int, page *Page) (*Page, *http.Response, []byte, error) {
-	var updated Page
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Update(entityURL, page, &updated)
-
-	// set collection object for each entity which has sub-collection
-	updated.setCollection(col)
-
-	return &updated, resp, body, err
-}
-func (col *PagesCollection) Delete(id int, params interface{}) (*Page, *http.Response, []byte, error) {
-	var deleted Page
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-
-	resp, body, err := col.client.Delete(entityURL, params, &deleted)
-
-	// set collection object for each entity which has sub-collection
-	deleted.setCollection(col)
-
-	return &deleted, resp, body, err
-}
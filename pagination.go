@@ -0,0 +1,136 @@
+package wordpress
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Pagination describes the page of a collection returned by a List call, as
+// reported by the X-WP-Total, X-WP-TotalPages and Link response headers.
+type Pagination struct {
+	Total      int
+	TotalPages int
+	// Next and Prev are the URLs of the adjacent pages, empty on the last
+	// and first page respectively.
+	Next string
+	Prev string
+}
+
+// ParsePagination reads the pagination headers of resp. It returns nil if
+// resp is nil.
+func ParsePagination(resp *http.Response) *Pagination {
+	if resp == nil {
+		return nil
+	}
+	pagination := &Pagination{}
+	pagination.Total, _ = strconv.Atoi(resp.Header.Get("X-WP-Total"))
+	pagination.TotalPages, _ = strconv.Atoi(resp.Header.Get("X-WP-TotalPages"))
+	for _, link := range resp.Header.Values("Link") {
+		for _, part := range strings.Split(link, ",") {
+			target, rel, ok := parseLink(part)
+			if !ok {
+				continue
+			}
+			switch rel {
+			case "next":
+				pagination.Next = target
+			case "prev":
+				pagination.Prev = target
+			}
+		}
+	}
+	return pagination
+}
+
+// parseLink parses one `<url>; rel="name"` entry of a Link header.
+func parseLink(link string) (target string, rel string, ok bool) {
+	segments := strings.Split(link, ";")
+	target = strings.TrimSpace(segments[0])
+	if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+		return "", "", false
+	}
+	target = target[1 : len(target)-1]
+	for _, segment := range segments[1:] {
+		key, value, found := strings.Cut(strings.TrimSpace(segment), "=")
+		if found && strings.EqualFold(key, "rel") {
+			return target, strings.Trim(value, `"`), true
+		}
+	}
+	return "", "", false
+}
+
+// nextPageURL applies the query of the next page link to the URL that was
+// requested, so pages are fetched from the configured host even when
+// Wordpress advertises a different site URL.
+func nextPageURL(requested string, next string) (string, error) {
+	nextURL, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+	requestedURL, err := url.Parse(requested)
+	if err != nil {
+		return "", err
+	}
+	requestedURL.RawQuery = nextURL.RawQuery
+	return requestedURL.String(), nil
+}
+
+// paginate returns an iterator over every item of the collection at url,
+// requesting pages as needed. bind, if not nil, is called on each item before
+// it is yielded. Iteration stops at the first error, which is yielded.
+func paginate[T any](ctx context.Context, client *Client, url string, params interface{}, bind func(*T)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		// the response may have been built by a middleware without a request,
+		// so next pages are resolved against the collection URL
+		collectionURL := url
+		for {
+			var items []T
+			resp, _, err := client.ListContext(ctx, url, params, &decodedList[T]{entities: &items})
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for i := range items {
+				if bind != nil {
+					bind(&items[i])
+				}
+				if !yield(items[i], nil) {
+					return
+				}
+			}
+
+			pagination := ParsePagination(resp)
+			if pagination.Next == "" || len(items) == 0 {
+				return
+			}
+			next, err := nextPageURL(collectionURL, pagination.Next)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if next == url || beyondLastPage(next, pagination.TotalPages) {
+				return
+			}
+			url = next
+			// the next page URL already carries the query
+			params = nil
+		}
+	}
+}
+
+// beyondLastPage reports whether the page requested by next is past
+// totalPages, for servers that keep advertising a next page.
+func beyondLastPage(next string, totalPages int) bool {
+	nextURL, err := url.Parse(next)
+	if err != nil || totalPages == 0 {
+		return false
+	}
+	page, err := strconv.Atoi(nextURL.Query().Get("page"))
+	return err == nil && page > totalPages
+}
@@ -0,0 +1,174 @@
+package wordpress_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+// paginatedHandler serves 5 posts, 2 per page, advertising page links on a
+// different host than the one requested
+func paginatedHandler(t *testing.T, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		if r.URL.Query().Get("search") != "hello" {
+			t.Errorf("Query should be kept across pages: %v", r.URL.RawQuery)
+		}
+		link := "http://example.com/wp-json/wp/v2/posts?page=%v&search=hello"
+		w.Header().Set("X-WP-Total", "5")
+		w.Header().Set("X-WP-TotalPages", "3")
+		if page > 1 {
+			w.Header().Add("Link", fmt.Sprintf(`<`+link+`>; rel="prev"`, page-1))
+		}
+		if page < 3 {
+			w.Header().Add("Link", fmt.Sprintf(`<`+link+`>; rel="next"`, page+1))
+		}
+		switch page {
+		case 1:
+			w.Write([]byte(`[{"id":1},{"id":2}]`))
+		case 2:
+			w.Write([]byte(`[{"id":3},{"id":4}]`))
+		default:
+			w.Write([]byte(`[{"id":5}]`))
+		}
+	}
+}
+
+func TestParsePagination(t *testing.T) {
+	requests := 0
+	wp := initTestServerClient(t, paginatedHandler(t, &requests))
+
+	_, resp, _, err := wp.Posts().List(url.Values{"search": {"hello"}, "page": {"2"}})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	pagination := wordpress.ParsePagination(resp)
+	if pagination.Total != 5 || pagination.TotalPages != 3 {
+		t.Errorf("Unexpected totals: %+v", pagination)
+	}
+	if pagination.Next != "http://example.com/wp-json/wp/v2/posts?page=3&search=hello" {
+		t.Errorf("Unexpected next link: %v", pagination.Next)
+	}
+	if pagination.Prev != "http://example.com/wp-json/wp/v2/posts?page=1&search=hello" {
+		t.Errorf("Unexpected prev link: %v", pagination.Prev)
+	}
+}
+
+func TestPostsAll(t *testing.T) {
+	requests := 0
+	wp := initTestServerClient(t, paginatedHandler(t, &requests))
+
+	var ids []int
+	for post, err := range wp.Posts().All(context.Background(), url.Values{"search": {"hello"}}) {
+		if err != nil {
+			t.Fatalf("Should not return error: %v", err.Error())
+		}
+		if post.Meta() == nil {
+			t.Errorf("Posts should be bound to their collection")
+		}
+		ids = append(ids, post.ID)
+	}
+	if fmt.Sprint(ids) != "[1 2 3 4 5]" {
+		t.Errorf("Expected all posts, got %v", ids)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %v", requests)
+	}
+}
+
+func TestPostsAll_Break(t *testing.T) {
+	requests := 0
+	wp := initTestServerClient(t, paginatedHandler(t, &requests))
+
+	for post, err := range wp.Posts().All(context.Background(), url.Values{"search": {"hello"}}) {
+		if err != nil {
+			t.Fatalf("Should not return error: %v", err.Error())
+		}
+		if post.ID == 2 {
+			break
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %v", requests)
+	}
+}
+
+func TestPostsAll_Error(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	for _, err := range wp.Posts().All(context.Background(), nil) {
+		if !wordpress.IsForbidden(err) {
+			t.Errorf("Expected forbidden error, got %v", err)
+		}
+	}
+}
+
+func TestPostsAll_StopsAtTotalPages(t *testing.T) {
+	requests := 0
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		w.Header().Set("X-WP-TotalPages", "2")
+		w.Header().Set("Link", fmt.Sprintf(`<http://example.com/wp-json/wp/v2/posts?page=%v>; rel="next"`, page+1))
+		w.Write([]byte(fmt.Sprintf(`[{"id":%v}]`, page)))
+	})
+
+	count := 0
+	for _, err := range wp.Posts().All(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("Should not return error: %v", err.Error())
+		}
+		count++
+	}
+	if count != 2 || requests != 2 {
+		t.Errorf("Expected 2 pages, got %v posts in %v requests", count, requests)
+	}
+}
+
+func TestPostsAll_ResponseWithoutRequest(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Request should be answered by the middleware")
+	})
+	wp.Use(func(next wordpress.Handler) wordpress.Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			page := req.URL.Query().Get("page")
+			header := http.Header{"X-Wp-Totalpages": {"2"}}
+			if page == "" {
+				header.Set("Link", `<http://example.com/wp-json/wp/v2/posts?page=2>; rel="next"`)
+				page = "1"
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`[{"id":` + page + `}]`)),
+			}, nil
+		}
+	})
+
+	var ids []int
+	for post, err := range wp.Posts().All(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("Should not return error: %v", err.Error())
+		}
+		ids = append(ids, post.ID)
+	}
+	if fmt.Sprint(ids) != "[1 2]" {
+		t.Errorf("Unexpected posts: %v", ids)
+	}
+}
@@ -0,0 +1,207 @@
+package wordpress
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ContextView  = "view"
+	ContextEmbed = "embed"
+	ContextEdit  = "edit"
+
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// ListParams are the query parameters shared by most collections. It is
+// embedded in the typed parameters of each collection, e.g. PostListParams.
+//
+// Params passed to List, Get and Delete may be one of these structs, any
+// struct with `url` field tags, url.Values, a map, or a raw query string
+// such as "context=edit".
+type ListParams struct {
+	Context string `url:"context,omitempty"`
+	Page    int    `url:"page,omitempty"`
+	PerPage int    `url:"per_page,omitempty"`
+	Search  string `url:"search,omitempty"`
+	Exclude []int  `url:"exclude,omitempty"`
+	Include []int  `url:"include,omitempty"`
+	Offset  int    `url:"offset,omitempty"`
+	Order   string `url:"order,omitempty"`
+	OrderBy string `url:"orderby,omitempty"`
+}
+
+// DeleteParams are the query parameters of Delete calls.
+type DeleteParams struct {
+	// Force bypasses the trash. Required for resources without trash
+	// support, e.g. terms and users.
+	Force bool `url:"force,omitempty"`
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// encodeQuery converts params to query values. Slices are sent as
+// comma-separated lists, booleans as "true"/"false" and times in RFC 3339
+// format, the way WP-API parses them.
+func encodeQuery(params interface{}) (url.Values, error) {
+	switch p := params.(type) {
+	case nil:
+		return url.Values{}, nil
+	case url.Values:
+		return p, nil
+	case string:
+		return url.ParseQuery(strings.TrimPrefix(p, "?"))
+	case map[string]string:
+		values := url.Values{}
+		for k, v := range p {
+			values.Set(k, v)
+		}
+		return values, nil
+	case map[string][]string:
+		return url.Values(p), nil
+	}
+
+	v := reflect.ValueOf(params)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return url.Values{}, nil
+		}
+		v = v.Elem()
+	}
+	values := url.Values{}
+	switch v.Kind() {
+	case reflect.Struct:
+		if err := encodeStruct(values, v); err != nil {
+			return nil, err
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("wordpress: unsupported params type %T", params)
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			s, ok, err := encodeValue(iter.Value())
+			if err != nil {
+				return nil, fmt.Errorf("wordpress: param %v: %w", iter.Key().String(), err)
+			}
+			if ok {
+				values.Set(iter.Key().String(), s)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("wordpress: unsupported params type %T", params)
+	}
+	return values, nil
+}
+
+func encodeStruct(values url.Values, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("url")
+		if tag == "-" {
+			continue
+		}
+		fv := v.Field(i)
+		if field.Anonymous && tag == "" {
+			for fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					break
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				if err := encodeStruct(values, fv); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		if opts == "omitempty" && fv.IsZero() {
+			continue
+		}
+		s, ok, err := encodeValue(fv)
+		if err != nil {
+			return fmt.Errorf("wordpress: param %v: %w", name, err)
+		}
+		if ok {
+			values.Set(name, s)
+		}
+	}
+	return nil
+}
+
+// encodeValue formats a single parameter value. ok is false for nil values.
+func encodeValue(v reflect.Value) (s string, ok bool, err error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", false, nil
+		}
+		v = v.Elem()
+	}
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).Format(time.RFC3339), true, nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true, nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true, nil
+	case reflect.Slice, reflect.Array:
+		items := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			item, ok, err := encodeValue(v.Index(i))
+			if err != nil {
+				return "", false, err
+			}
+			if ok {
+				items = append(items, item)
+			}
+		}
+		return strings.Join(items, ","), true, nil
+	}
+	if stringer, ok := v.Interface().(fmt.Stringer); ok {
+		return stringer.String(), true, nil
+	}
+	return "", false, fmt.Errorf("unsupported type %v", v.Type())
+}
+
+// withQuery returns rawURL with params added to its query.
+func withQuery(rawURL string, params interface{}) (string, error) {
+	values, err := encodeQuery(params)
+	if err != nil {
+		return "", err
+	}
+	if len(values) == 0 {
+		return rawURL, nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	for k, vs := range values {
+		query[k] = vs
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
@@ -0,0 +1,122 @@
+package wordpress_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func captureQuery(t *testing.T, query *url.Values) *wordpress.Client {
+	return initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		*query = r.URL.Query()
+		w.Write([]byte(`[]`))
+	})
+}
+
+func TestParams_PostListParams(t *testing.T) {
+	var query url.Values
+	wp := captureQuery(t, &query)
+
+	sticky := false
+	after := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+	_, _, _, err := wp.Posts().List(&wordpress.PostListParams{
+		ListParams: wordpress.ListParams{
+			Context: wordpress.ContextEdit,
+			PerPage: 100,
+			Search:  "hello world",
+			Include: []int{1000000, 2000001},
+			Order:   wordpress.OrderAsc,
+		},
+		After:      after,
+		Author:     []int{1},
+		Categories: []int{3, 4},
+		Slug:       []string{"first", "second"},
+		Status:     []string{wordpress.PostStatusPublish, wordpress.PostStatusDraft},
+		Sticky:     &sticky,
+	})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+
+	expected := url.Values{
+		"context":    {"edit"},
+		"per_page":   {"100"},
+		"search":     {"hello world"},
+		"include":    {"1000000,2000001"},
+		"order":      {"asc"},
+		"after":      {"2024-05-01T10:30:00Z"},
+		"author":     {"1"},
+		"categories": {"3,4"},
+		"slug":       {"first,second"},
+		"status":     {"publish,draft"},
+		"sticky":     {"false"},
+	}
+	if query.Encode() != expected.Encode() {
+		t.Errorf("Unexpected query:\n%v\nexpected:\n%v", query.Encode(), expected.Encode())
+	}
+}
+
+func TestParams_TermListParamsTopLevel(t *testing.T) {
+	var query url.Values
+	wp := captureQuery(t, &query)
+
+	parent := 0
+	wp.Terms().Category().List(wordpress.TermListParams{Parent: &parent})
+	if query.Get("parent") != "0" {
+		t.Errorf("Expected parent=0, got %v", query.Encode())
+	}
+	if query.Has("hide_empty") {
+		t.Errorf("Unset params should be omitted, got %v", query.Encode())
+	}
+}
+
+func TestParams_String(t *testing.T) {
+	var query url.Values
+	wp := captureQuery(t, &query)
+
+	wp.Posts().List("context=edit&per_page=5")
+	if query.Get("context") != "edit" || query.Get("per_page") != "5" {
+		t.Errorf("Unexpected query: %v", query.Encode())
+	}
+}
+
+func TestParams_Map(t *testing.T) {
+	var query url.Values
+	wp := captureQuery(t, &query)
+
+	wp.Posts().List(map[string]interface{}{
+		"include": []int{1234567, 7654321},
+		"sticky":  true,
+	})
+	if query.Get("include") != "1234567,7654321" || query.Get("sticky") != "true" {
+		t.Errorf("Unexpected query: %v", query.Encode())
+	}
+}
+
+func TestParams_Delete(t *testing.T) {
+	var query url.Values
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"id":1}`))
+	})
+
+	wp.Users().Delete(1, &wordpress.UserDeleteParams{
+		DeleteParams: wordpress.DeleteParams{Force: true},
+		Reassign:     2,
+	})
+	if query.Get("force") != "true" || query.Get("reassign") != "2" || query.Get("_method") != "DELETE" {
+		t.Errorf("Unexpected query: %v", query.Encode())
+	}
+}
+
+func TestParams_Unsupported(t *testing.T) {
+	wp := captureQuery(t, new(url.Values))
+
+	_, _, _, err := wp.Posts().List(42)
+	if err == nil {
+		t.Errorf("Should return error for unsupported params")
+	}
+}
@@ -0,0 +1,127 @@
+package wordpress
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	PluginStatusActive        = "active"
+	PluginStatusInactive      = "inactive"
+	PluginStatusNetworkActive = "network-active"
+)
+
+// Plugin is an installed plugin. The REST API does not report available
+// updates.
+type Plugin struct {
+	// Plugin identifies the plugin by its file without the ".php" extension,
+	// e.g. "akismet/akismet" or "hello".
+	Plugin      string       `json:"plugin,omitempty"`
+	Status      string       `json:"status,omitempty"`
+	Name        string       `json:"name,omitempty"`
+	PluginURI   string       `json:"plugin_uri,omitempty"`
+	Author      string       `json:"author,omitempty"`
+	AuthorURI   string       `json:"author_uri,omitempty"`
+	Description RenderedText `json:"description,omitempty"`
+	Version     string       `json:"version,omitempty"`
+	NetworkOnly bool         `json:"network_only,omitempty"`
+	RequiresWP  string       `json:"requires_wp,omitempty"`
+	RequiresPHP string       `json:"requires_php,omitempty"`
+	TextDomain  string       `json:"textdomain,omitempty"`
+	Links       Links        `json:"_links,omitempty"`
+}
+
+// Active reports whether the plugin is active on the site or network.
+func (entity *Plugin) Active() bool {
+	return entity.Status == PluginStatusActive || entity.Status == PluginStatusNetworkActive
+}
+
+// PluginListParams are the query parameters of PluginsCollection.List.
+type PluginListParams struct {
+	Context string   `url:"context,omitempty"`
+	Search  string   `url:"search,omitempty"`
+	Status  []string `url:"status,omitempty"`
+}
+
+// PluginsCollection is the `/plugins` collection. Managing plugins requires
+// the activate_plugins, install_plugins or delete_plugins capabilities.
+type PluginsCollection struct {
+	client *Client
+	url    string
+}
+
+// pluginURL returns the URL of plugin, which may be given as its file, e.g.
+// "akismet/akismet.php". The slash between the plugin directory and file is
+// part of the route, other characters are escaped.
+func (col *PluginsCollection) pluginURL(plugin string) string {
+	return fmt.Sprintf("%v/%v", col.url, escapePath(strings.TrimSuffix(plugin, ".php")))
+}
+
+func (col *PluginsCollection) List(params interface{}) ([]Plugin, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *PluginsCollection) ListContext(ctx context.Context, params interface{}) ([]Plugin, *http.Response, []byte, error) {
+	var plugins []Plugin
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &plugins)
+	return plugins, resp, body, err
+}
+
+func (col *PluginsCollection) Get(plugin string, params interface{}) (*Plugin, *http.Response, []byte, error) {
+	return col.GetContext(context.Background(), plugin, params)
+}
+func (col *PluginsCollection) GetContext(ctx context.Context, plugin string, params interface{}) (*Plugin, *http.Response, []byte, error) {
+	var entity Plugin
+	resp, body, err := col.client.GetContext(ctx, col.pluginURL(plugin), params, &entity)
+	return &entity, resp, body, err
+}
+
+// Install installs the plugin slug from the wordpress.org plugin directory,
+// with status PluginStatusActive or PluginStatusInactive (the default if
+// empty).
+func (col *PluginsCollection) Install(slug string, status string) (*Plugin, *http.Response, []byte, error) {
+	return col.InstallContext(context.Background(), slug, status)
+}
+func (col *PluginsCollection) InstallContext(ctx context.Context, slug string, status string) (*Plugin, *http.Response, []byte, error) {
+	var installed Plugin
+	content := map[string]string{"slug": slug}
+	if status != "" {
+		content["status"] = status
+	}
+	resp, body, err := col.client.CreateContext(ctx, col.url, content, &installed)
+	return &installed, resp, body, err
+}
+
+// SetStatus changes the status of plugin, activating or deactivating it.
+func (col *PluginsCollection) SetStatus(plugin string, status string) (*Plugin, *http.Response, []byte, error) {
+	return col.SetStatusContext(context.Background(), plugin, status)
+}
+func (col *PluginsCollection) SetStatusContext(ctx context.Context, plugin string, status string) (*Plugin, *http.Response, []byte, error) {
+	var updated Plugin
+	resp, body, err := col.client.UpdateContext(ctx, col.pluginURL(plugin), map[string]string{"status": status}, &updated)
+	return &updated, resp, body, err
+}
+
+func (col *PluginsCollection) Activate(plugin string) (*Plugin, *http.Response, []byte, error) {
+	return col.SetStatusContext(context.Background(), plugin, PluginStatusActive)
+}
+func (col *PluginsCollection) ActivateContext(ctx context.Context, plugin string) (*Plugin, *http.Response, []byte, error) {
+	return col.SetStatusContext(ctx, plugin, PluginStatusActive)
+}
+func (col *PluginsCollection) Deactivate(plugin string) (*Plugin, *http.Response, []byte, error) {
+	return col.SetStatusContext(context.Background(), plugin, PluginStatusInactive)
+}
+func (col *PluginsCollection) DeactivateContext(ctx context.Context, plugin string) (*Plugin, *http.Response, []byte, error) {
+	return col.SetStatusContext(ctx, plugin, PluginStatusInactive)
+}
+
+// Delete uninstalls plugin, which must be inactive, and returns it.
+func (col *PluginsCollection) Delete(plugin string) (*Plugin, *http.Response, []byte, error) {
+	return col.DeleteContext(context.Background(), plugin)
+}
+func (col *PluginsCollection) DeleteContext(ctx context.Context, plugin string) (*Plugin, *http.Response, []byte, error) {
+	var deleted Plugin
+	resp, body, err := col.client.DeleteContext(ctx, col.pluginURL(plugin), nil, &deletedEntity[Plugin]{entity: &deleted})
+	return &deleted, resp, body, err
+}
@@ -0,0 +1,76 @@
+package wordpress_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestPlugins(t *testing.T) {
+	var sent map[string]string
+	wp := initTestRoutesClient(t, map[string]http.HandlerFunc{
+		"GET /plugins": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("status") != "active" {
+				t.Errorf("Unexpected query: %v", r.URL.RawQuery)
+			}
+			w.Write([]byte(`[{"plugin":"akismet/akismet","status":"active","name":"Akismet Anti-spam","version":"5.3","network_only":false,"requires_wp":"5.8","requires_php":"5.6.20","textdomain":"akismet","description":{"raw":"Spam protection","rendered":"Spam protection"}}]`))
+		},
+		"GET /plugins/hello": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"plugin":"hello","status":"inactive"}`))
+		},
+		"GET /plugins/my%20plugin/my%23plugin": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"plugin":"my plugin/my#plugin","status":"inactive"}`))
+		},
+		"POST /plugins": func(w http.ResponseWriter, r *http.Request) {
+			sent = nil
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"plugin":"classic-editor/classic-editor","status":"` + sent["status"] + `"}`))
+		},
+		"PUT /plugins/akismet/akismet": func(w http.ResponseWriter, r *http.Request) {
+			sent = nil
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.Write([]byte(`{"plugin":"akismet/akismet","status":"` + sent["status"] + `"}`))
+		},
+		"DELETE /plugins/akismet/akismet": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"deleted":true,"previous":{"plugin":"akismet/akismet","status":"inactive"}}`))
+		},
+	})
+	plugins := wp.Plugins()
+
+	list, _, _, err := plugins.List(&wordpress.PluginListParams{Status: []string{wordpress.PluginStatusActive}})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if len(list) != 1 || !list[0].Active() || list[0].RequiresPHP != "5.6.20" || list[0].Description.Raw != "Spam protection" {
+		t.Errorf("Unexpected plugins: %+v", list)
+	}
+
+	if hello, _, _, err := plugins.Get("hello.php", nil); err != nil || hello.Active() {
+		t.Errorf("Unexpected plugin: %+v, %v", hello, err)
+	}
+	if odd, _, _, err := plugins.Get("my plugin/my#plugin", nil); err != nil || odd.Plugin != "my plugin/my#plugin" {
+		t.Errorf("Plugin identifiers should be escaped: %+v, %v", odd, err)
+	}
+
+	installed, _, _, err := plugins.Install("classic-editor", wordpress.PluginStatusActive)
+	if err != nil || sent["slug"] != "classic-editor" || installed.Status != "active" {
+		t.Errorf("Unexpected install: %v, %+v, %v", sent, installed, err)
+	}
+
+	deactivated, _, _, err := plugins.Deactivate("akismet/akismet.php")
+	if err != nil || deactivated.Status != "inactive" {
+		t.Errorf("Unexpected deactivation: %+v, %v", deactivated, err)
+	}
+	activated, _, _, err := plugins.Activate("akismet/akismet")
+	if err != nil || activated.Status != "active" {
+		t.Errorf("Unexpected activation: %+v, %v", activated, err)
+	}
+
+	deleted, _, _, err := plugins.Delete("akismet/akismet")
+	if err != nil || deleted.Plugin != "akismet/akismet" {
+		t.Errorf("Unexpected deleted plugin: %+v, %v", deleted, err)
+	}
+}
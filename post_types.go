@@ -0,0 +1,129 @@
+package wordpress
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// PostTypeCollection is the collection of a post type resolved from
+// `/types`, such as a custom post type, decoded into T. T is usually Post,
+// or a struct embedding Post with the type's extra fields:
+//
+//	type Event struct {
+//		wordpress.Post
+//		Venue string `json:"venue,omitempty"`
+//	}
+//	events, err := wordpress.PostTypeOf[Event](ctx, client, "event")
+//
+// Entities embedding Post get their Meta(), Revisions() and Terms()
+// sub-collections wired to this collection.
+type PostTypeCollection[T any] struct {
+	*Collection[T]
+
+	// Type is the post type as returned by `/types/[slug]`.
+	Type *Type
+}
+
+// postBinder is implemented by *Post and by pointers to structs embedding Post.
+type postBinder interface {
+	setCollection(col *PostsCollection)
+}
+
+// PostType returns the collection of the post type slug, decoded into Post.
+func (client *Client) PostType(slug string) (*PostTypeCollection[Post], error) {
+	return client.PostTypeContext(context.Background(), slug)
+}
+func (client *Client) PostTypeContext(ctx context.Context, slug string) (*PostTypeCollection[Post], error) {
+	return PostTypeOf[Post](ctx, client, slug)
+}
+
+// PostTypeOf returns the collection of the post type slug, decoded into T.
+// The type is fetched with `context=edit` when the credentials allow it, so
+// that its supported features are known.
+func PostTypeOf[T any](ctx context.Context, client *Client, slug string) (*PostTypeCollection[T], error) {
+	postType, _, _, err := client.Types().GetContext(ctx, slug, ListParams{Context: ContextEdit})
+	if IsUnauthorized(err) || IsForbidden(err) {
+		postType, _, _, err = client.Types().GetContext(ctx, slug, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("wordpress: resolving post type %v: %w", slug, err)
+	}
+	if postType.RestBase == "" {
+		return nil, fmt.Errorf("wordpress: post type %v is not available in the REST API", slug)
+	}
+
+	url := client.namespaceURL(postType.RestNamespace, postType.RestBase)
+	posts := newPostsCollection(client, url)
+	col := &PostTypeCollection[T]{
+		Type: postType,
+	}
+	col.Collection = newCollection(client, url, func(entity *T) {
+		if binder, ok := any(entity).(postBinder); ok {
+			binder.setCollection(posts)
+		}
+	})
+	return col, nil
+}
+
+// namespaceURL returns the URL of restBase in namespace, which may differ
+// from the `wp/v2` namespace of Options.BaseAPIURL.
+func (client *Client) namespaceURL(namespace string, restBase string) string {
+	base := client.baseURL
+	if namespace != "" && namespace != "wp/v2" && strings.HasSuffix(base, "/wp/v2") {
+		base = strings.TrimSuffix(base, "wp/v2") + namespace
+	}
+	return fmt.Sprintf("%v/%v", base, restBase)
+}
+
+// Meta returns the meta sub-collection of the entity id, or nil if the type
+// does not support custom fields.
+func (col *PostTypeCollection[T]) Meta(id int) *MetaCollection {
+	if !col.Type.HasSupport("custom-fields") {
+		return nil
+	}
+	return &MetaCollection{
+		client:     col.client,
+		parentType: col.Type.RestBase,
+		url:        fmt.Sprintf("%v/%v/%v", col.url, id, CollectionMeta),
+	}
+}
+
+// Revisions returns the revisions sub-collection of the entity id, or nil if
+// the type does not support revisions.
+func (col *PostTypeCollection[T]) Revisions(id int) *RevisionsCollection {
+	if !col.Type.HasSupport("revisions") {
+		return nil
+	}
+	return &RevisionsCollection{
+		client:     col.client,
+		parentType: col.Type.RestBase,
+		url:        fmt.Sprintf("%v/%v/%v", col.url, id, CollectionRevisions),
+	}
+}
+
+// Autosaves returns the autosaves sub-collection of the entity id, or nil if
+// the type does not support the editor.
+func (col *PostTypeCollection[T]) Autosaves(id int) *AutosavesCollection {
+	if !col.Type.HasSupport("editor") {
+		return nil
+	}
+	return &AutosavesCollection{
+		client:     col.client,
+		parentType: col.Type.RestBase,
+		url:        fmt.Sprintf("%v/%v/%v", col.url, id, CollectionAutosaves),
+	}
+}
+
+// Terms returns the terms sub-collection of the entity id, or nil if the type
+// has no taxonomies.
+func (col *PostTypeCollection[T]) Terms(id int) *PostsTermsCollection {
+	if len(col.Type.Taxonomies) == 0 {
+		return nil
+	}
+	return &PostsTermsCollection{
+		client:     col.client,
+		parentType: col.Type.RestBase,
+		url:        fmt.Sprintf("%v/%v/%v", col.url, id, CollectionTerms),
+	}
+}
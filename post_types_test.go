@@ -0,0 +1,113 @@
+package wordpress_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+type testEvent struct {
+	wordpress.Post
+	Venue string `json:"venue,omitempty"`
+}
+
+func initTestPostTypeClient(t *testing.T) *wordpress.Client {
+	return initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/types/event":
+			if r.URL.Query().Get("context") != "edit" {
+				t.Errorf("Post type should be fetched with context=edit")
+			}
+			w.Write([]byte(`{"name":"Events","slug":"event","rest_base":"events","rest_namespace":"wp/v2","taxonomies":["genre"],"supports":{"title":true,"editor":true,"revisions":true}}`))
+		case "/types/product":
+			if r.URL.Query().Get("context") == "edit" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"code":"rest_forbidden_context","message":"Sorry, you are not allowed to edit posts in this post type.","data":{"status":401}}`))
+				return
+			}
+			w.Write([]byte(`{"name":"Products","slug":"product","rest_base":"products","rest_namespace":"shop/v1"}`))
+		case "/events":
+			w.Write([]byte(`[{"id":1,"type":"event","title":{"rendered":"Launch"},"venue":"Main hall"}]`))
+		case "/events/1":
+			w.Write([]byte(`{"id":1,"type":"event","title":{"rendered":"Launch"},"venue":"Main hall"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"rest_no_route","message":"No route was found matching the URL and request method.","data":{"status":404}}`))
+		}
+	})
+}
+
+func TestPostType(t *testing.T) {
+	wp := initTestPostTypeClient(t)
+
+	events, err := wp.PostType("event")
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if events.Type.RestBase != "events" {
+		t.Errorf("Unexpected post type: %+v", events.Type)
+	}
+
+	list, _, _, err := events.List(nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if len(list) != 1 || list[0].Title.Rendered != "Launch" {
+		t.Errorf("Unexpected events: %+v", list)
+	}
+	if list[0].Meta() == nil {
+		t.Errorf("Events should be bound to their collection")
+	}
+	if events.Revisions(1) == nil || events.Autosaves(1) == nil || events.Terms(1) == nil {
+		t.Errorf("Supported sub-collections should not be nil")
+	}
+	if events.Meta(1) != nil {
+		t.Errorf("Meta should be nil when custom-fields are not supported")
+	}
+}
+
+func TestPostTypeOf_CustomStruct(t *testing.T) {
+	wp := initTestPostTypeClient(t)
+
+	events, err := wordpress.PostTypeOf[testEvent](context.Background(), wp, "event")
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	event, _, _, err := events.Get(1, nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if event.Venue != "Main hall" || event.Title.Rendered != "Launch" {
+		t.Errorf("Unexpected event: %+v", event)
+	}
+	if event.Revisions() == nil {
+		t.Errorf("Structs embedding Post should be bound to their collection")
+	}
+}
+
+func TestPostType_OtherNamespaceWithoutEditContext(t *testing.T) {
+	wp := initTestPostTypeClient(t)
+
+	products, err := wp.PostType("product")
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if !strings.HasSuffix(products.URL(), "/products") {
+		t.Errorf("Unexpected collection URL: %v", products.URL())
+	}
+	if products.Meta(1) == nil {
+		t.Errorf("Sub-collections should be available when supports are unknown")
+	}
+}
+
+func TestPostType_Unknown(t *testing.T) {
+	wp := initTestPostTypeClient(t)
+
+	_, err := wp.PostType("missing")
+	if !wordpress.IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
@@ -1,8 +1,13 @@
 package wordpress
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
+	"time"
 )
 
 const (
@@ -44,46 +49,153 @@ type Title struct {
 	Rendered string `json:"rendered,omitempty"`
 }
 type Content struct {
-	Raw      string `json:"raw,omitempty"`
-	Rendered string `json:"rendered,omitempty"`
+	Raw          string `json:"raw,omitempty"`
+	Rendered     string `json:"rendered,omitempty"`
+	Protected    bool   `json:"protected,omitempty"`
+	BlockVersion int    `json:"block_version,omitempty"`
 }
 type Excerpt struct {
-	Raw      string `json:"raw,omitempty"`
-	Rendered string `json:"rendered,omitempty"`
+	Raw       string `json:"raw,omitempty"`
+	Rendered  string `json:"rendered,omitempty"`
+	Protected bool   `json:"protected,omitempty"`
+}
+
+// MetaFields are the registered meta fields of an entity, sent as `meta`.
+// WordPress encodes an empty object as `[]`, which decodes to an empty map.
+type MetaFields map[string]interface{}
+
+func (meta *MetaFields) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" || string(data) == "[]" {
+		*meta = MetaFields{}
+		return nil
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	*meta = fields
+	return nil
+}
+
+// ClassList are the CSS classes of a post, as returned in `class_list`.
+// WordPress may send them as an object keyed by index instead of a list.
+type ClassList []string
+
+func (list *ClassList) UnmarshalJSON(data []byte) error {
+	var classes []string
+	if err := json.Unmarshal(data, &classes); err == nil {
+		*list = classes
+		return nil
+	}
+	indexed := map[string]string{}
+	if err := json.Unmarshal(data, &indexed); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(indexed))
+	for key := range indexed {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, errA := strconv.Atoi(keys[i])
+		b, errB := strconv.Atoi(keys[j])
+		if errA != nil || errB != nil {
+			return keys[i] < keys[j]
+		}
+		return a < b
+	})
+	classes = make([]string, 0, len(keys))
+	for _, key := range keys {
+		classes = append(classes, indexed[key])
+	}
+	*list = classes
+	return nil
+}
+
+// Link is a HAL link of an entity's `_links`.
+type Link struct {
+	Href       string `json:"href"`
+	Name       string `json:"name,omitempty"`
+	Templated  bool   `json:"templated,omitempty"`
+	Embeddable bool   `json:"embeddable,omitempty"`
+	Taxonomy   string `json:"taxonomy,omitempty"`
+	Count      int    `json:"count,omitempty"`
+	ID         int    `json:"id,omitempty"`
+}
+
+// Links are the `_links` of an entity, keyed by relation, e.g. "self",
+// "author" or "wp:term".
+type Links map[string][]Link
+
+// Href returns the first href of the relation rel, or "" if there is none.
+func (links Links) Href(rel string) string {
+	if len(links[rel]) == 0 {
+		return ""
+	}
+	return links[rel][0].Href
 }
 
 type Post struct {
 	collection *PostsCollection `json:"-"`
 
-	ID            int     `json:"id,omitempty"`
-	Date          string  `json:"date,omitempty"`
-	DateGMT       string  `json:"date_gmt,omitempty"`
-	GUID          GUID    `json:"guid,omitempty"`
-	Link          string  `json:"link,omitempty"`
-	Modified      string  `json:"modified,omitempty"`
-	ModifiedGMT   string  `json:"modified_gmt,omitempty"`
-	Password      string  `json:"password,omitempty"`
-	Slug          string  `json:"slug,omitempty"`
-	Status        string  `json:"status,omitempty"`
-	Type          string  `json:"type,omitempty"`
-	Title         Title   `json:"title,omitempty"`
-	Content       Content `json:"content,omitempty"`
-	Author        int     `json:"author,omitempty"`
-	Excerpt       Excerpt `json:"excerpt,omitempty"`
-	FeaturedMedia int     `json:"featured_media,omitempty"`
-	CommentStatus string  `json:"comment_status,omitempty"`
-	PingStatus    string  `json:"ping_status,omitempty"`
-	Format        string  `json:"format,omitempty"`
-	Sticky        bool    `json:"sticky,omitempty"`
+	ID                int        `json:"id,omitempty"`
+	Date              string     `json:"date,omitempty"`
+	DateGMT           string     `json:"date_gmt,omitempty"`
+	GUID              GUID       `json:"guid,omitempty"`
+	Link              string     `json:"link,omitempty"`
+	Modified          string     `json:"modified,omitempty"`
+	ModifiedGMT       string     `json:"modified_gmt,omitempty"`
+	Password          string     `json:"password,omitempty"`
+	Slug              string     `json:"slug,omitempty"`
+	Status            string     `json:"status,omitempty"`
+	Type              string     `json:"type,omitempty"`
+	PermalinkTemplate string     `json:"permalink_template,omitempty"`
+	GeneratedSlug     string     `json:"generated_slug,omitempty"`
+	Title             Title      `json:"title,omitempty"`
+	Content           Content    `json:"content,omitempty"`
+	Author            int        `json:"author,omitempty"`
+	Excerpt           Excerpt    `json:"excerpt,omitempty"`
+	FeaturedMedia     int        `json:"featured_media,omitempty"`
+	CommentStatus     string     `json:"comment_status,omitempty"`
+	PingStatus        string     `json:"ping_status,omitempty"`
+	Format            string     `json:"format,omitempty"`
+	MetaFields        MetaFields `json:"meta,omitempty"`
+	Sticky            bool       `json:"sticky,omitempty"`
+	Template          string     `json:"template,omitempty"`
+	// Categories and Tags are term IDs. As for TaxonomyTerms, an empty list
+	// removes the post from all of its terms, while nil leaves them unchanged.
+	Categories []int     `json:"categories,omitempty"`
+	Tags       []int     `json:"tags,omitempty"`
+	ClassList  ClassList `json:"class_list,omitempty"`
+	Links      Links     `json:"_links,omitempty"`
+	// TaxonomyTerms holds the term IDs of other taxonomies, e.g. custom ones,
+	// keyed by the taxonomy's rest_base. It is filled for the taxonomies
+	// linked from `_links["wp:term"]`, and sent along with the post.
+	// Set a rest_base to an empty list to remove all of its terms.
+	TaxonomyTerms TaxonomyTerms `json:"-"`
 }
 
 func (entity *Post) setCollection(col *PostsCollection) {
 	entity.collection = col
 }
+func (entity *Post) decodeRaw(raw []byte, known map[string]bool) {
+	entity.TaxonomyTerms = decodeTaxonomyTerms(raw, known)
+}
+func (entity *Post) encodeRaw() map[string]interface{} {
+	fields := entity.TaxonomyTerms.fields()
+	// empty lists are dropped by omitempty, but must be sent to clear terms
+	for name, ids := range map[string][]int{"categories": entity.Categories, "tags": entity.Tags} {
+		if ids != nil && len(ids) == 0 {
+			if fields == nil {
+				fields = map[string]interface{}{}
+			}
+			fields[name] = ids
+		}
+	}
+	return fields
+}
 func (entity *Post) Meta() *MetaCollection {
 	if entity.collection == nil {
 		// missing post.collection parent. Probably Post struct was initialized manually.
-		_warning("Missing parent post collection")
 		return nil
 	}
 	return &MetaCollection{
@@ -96,7 +208,6 @@ func (entity *Post) Meta() *MetaCollection {
 func (entity *Post) Revisions() *RevisionsCollection {
 	if entity.collection == nil {
 		// missing post.collection parent. Probably Post struct was initialized manually, not fetched from API
-		_warning("Missing parent post collection")
 		return nil
 	}
 	return &RevisionsCollection{
@@ -106,10 +217,21 @@ func (entity *Post) Revisions() *RevisionsCollection {
 		url:        fmt.Sprintf("%v/%v/%v", entity.collection.url, entity.ID, CollectionRevisions),
 	}
 }
+func (entity *Post) Autosaves() *AutosavesCollection {
+	if entity.collection == nil {
+		// missing post.collection parent. Probably Post struct was initialized manually, not fetched from API
+		return nil
+	}
+	return &AutosavesCollection{
+		client:     entity.collection.client,
+		parent:     entity,
+		parentType: CollectionPosts,
+		url:        fmt.Sprintf("%v/%v/%v", entity.collection.url, entity.ID, CollectionAutosaves),
+	}
+}
 func (entity *Post) Terms() *PostsTermsCollection {
 	if entity.collection == nil {
 		// missing post.collection parent. Probably Post struct was initialized manually, not fetched from API
-		_warning("Missing parent post collection")
 		return nil
 	}
 	return &PostsTermsCollection{
@@ -120,44 +242,44 @@ func (entity *Post) Terms() *PostsTermsCollection {
 	}
 }
 func (entity *Post) Populate(params interface{}) (*Post, *http.Response, []byte, error) {
-	return entity.collection.Get(entity.ID, params)
+	return entity.PopulateContext(context.Background(), params)
 }
-
-type PostsCollection struct {
-	client    *Client
-	url       string
-	entityURL string
+func (entity *Post) PopulateContext(ctx context.Context, params interface{}) (*Post, *http.Response, []byte, error) {
+	return entity.collection.GetContext(ctx, entity.ID, params)
 }
 
-func (col *PostsCollection) List(params interface{}) ([]Post, *http.Response, []byte, error) {
-	var posts []Post
-	resp, body, err := col.client.List(col.url, params, &posts)
-
-	// set collection object for each entity which has sub-collection
-	for i := range posts {
-		posts[i].setCollection(col)
-	}
+// PostListParams are the query parameters of PostsCollection.List.
+type PostListParams struct {
+	ListParams
 
-	return posts, resp, body, err
+	After             time.Time `url:"after,omitempty"`
+	Before            time.Time `url:"before,omitempty"`
+	ModifiedAfter     time.Time `url:"modified_after,omitempty"`
+	ModifiedBefore    time.Time `url:"modified_before,omitempty"`
+	Author            []int     `url:"author,omitempty"`
+	AuthorExclude     []int     `url:"author_exclude,omitempty"`
+	Categories        []int     `url:"categories,omitempty"`
+	CategoriesExclude []int     `url:"categories_exclude,omitempty"`
+	Tags              []int     `url:"tags,omitempty"`
+	TagsExclude       []int     `url:"tags_exclude,omitempty"`
+	TaxRelation       string    `url:"tax_relation,omitempty"`
+	Slug              []string  `url:"slug,omitempty"`
+	Status            []string  `url:"status,omitempty"`
+	Format            []string  `url:"format,omitempty"`
+	SearchColumns     []string  `url:"search_columns,omitempty"`
+	Sticky            *bool     `url:"sticky,omitempty"`
 }
-func (col *PostsCollection) Create(new *Post) (*Post, *http.Response, []byte, error) {
-	var created Post
-	resp, body, err := col.client.Create(col.url, new, &created)
-
-	created.setCollection(col)
 
-	return &created, resp, body, err
+type PostsCollection struct {
+	*Collection[Post]
 }
-func (col *PostsCollection) Get(id int, params interface{}) (*Post, *http.Response, []byte, error) {
-	var entity Post
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Get(entityURL, params, &entity)
-
-	// set collection object for each entity which has sub-collection
-	entity.setCollection(col)
 
-	return &entity, resp, body, err
+func newPostsCollection(client *Client, url string) *PostsCollection {
+	col := &PostsCollection{}
+	col.Collection = newCollection(client, url, func(entity *Post) { entity.setCollection(col) })
+	return col
 }
+
 func (col *PostsCollection) Entity(id int) *Post {
 	entity := Post{
 		collection: col,
@@ -165,25 +287,3 @@ func (col *PostsCollection) Entity(id int) *Post {
 	}
 	return &entity
 }
-
-func (col *PostsCollection) Update(id int, post *Post) (*Post, *http.Response, []byte, error) {
-	var updated Post
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Update(entityURL, post, &updated)
-
-	// set collection object for each entity which has sub-collection
-	updated.setCollection(col)
-
-	return &updated, resp, body, err
-}
-func (col *PostsCollection) Delete(id int, params interface{}) (*Post, *http.Response, []byte, error) {
-	var deleted Post
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-
-	resp, body, err := col.client.Delete(entityURL, params, &deleted)
-
-	// set collection object for each entity which has sub-collection
-	deleted.setCollection(col)
-
-	return &deleted, resp, body, err
-}
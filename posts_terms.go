@@ -1,13 +1,14 @@
 package wordpress
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
 
 type PostsTerm struct {
 	ID          int    `json:"id,omitempty"`
-	Count       int    `json:"integer,omitempty"`
+	Count       int    `json:"count,omitempty"`
 	Description string `json:"description,omitempty"`
 	Link        string `json:"link,omitempty"`
 	Name        string `json:"name"`
@@ -24,9 +25,12 @@ type PostsTermsCollection struct {
 }
 
 func (col *PostsTermsCollection) List(taxonomy string, params interface{}) ([]PostsTerm, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), taxonomy, params)
+}
+func (col *PostsTermsCollection) ListContext(ctx context.Context, taxonomy string, params interface{}) ([]PostsTerm, *http.Response, []byte, error) {
 	var terms []PostsTerm
 	url := fmt.Sprintf("%v/%v", col.url, taxonomy)
-	resp, body, err := col.client.List(url, params, &terms)
+	resp, body, err := col.client.ListContext(ctx, url, params, &terms)
 	return terms, resp, body, err
 }
 func (col *PostsTermsCollection) Tag() *PostsTermsTaxonomyCollection {
@@ -51,25 +55,37 @@ type PostsTermsTaxonomyCollection struct {
 }
 
 func (col *PostsTermsTaxonomyCollection) List(params interface{}) ([]PostsTerm, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *PostsTermsTaxonomyCollection) ListContext(ctx context.Context, params interface{}) ([]PostsTerm, *http.Response, []byte, error) {
 	var terms []PostsTerm
-	resp, body, err := col.client.List(col.url, params, &terms)
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &terms)
 	return terms, resp, body, err
 }
 func (col *PostsTermsTaxonomyCollection) Create(id int) (*PostsTerm, *http.Response, []byte, error) {
+	return col.CreateContext(context.Background(), id)
+}
+func (col *PostsTermsTaxonomyCollection) CreateContext(ctx context.Context, id int) (*PostsTerm, *http.Response, []byte, error) {
 	var created PostsTerm
 	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Create(entityURL, nil, &created)
+	resp, body, err := col.client.CreateContext(ctx, entityURL, nil, &created)
 	return &created, resp, body, err
 }
 func (col *PostsTermsTaxonomyCollection) Get(id int, params interface{}) (*PostsTerm, *http.Response, []byte, error) {
+	return col.GetContext(context.Background(), id, params)
+}
+func (col *PostsTermsTaxonomyCollection) GetContext(ctx context.Context, id int, params interface{}) (*PostsTerm, *http.Response, []byte, error) {
 	var entity PostsTerm
 	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Get(entityURL, params, &entity)
+	resp, body, err := col.client.GetContext(ctx, entityURL, params, &entity)
 	return &entity, resp, body, err
 }
 func (col *PostsTermsTaxonomyCollection) Delete(id int, params interface{}) (*PostsTerm, *http.Response, []byte, error) {
+	return col.DeleteContext(context.Background(), id, params)
+}
+func (col *PostsTermsTaxonomyCollection) DeleteContext(ctx context.Context, id int, params interface{}) (*PostsTerm, *http.Response, []byte, error) {
 	var deleted PostsTerm
 	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Delete(entityURL, params, &deleted)
+	resp, body, err := col.client.DeleteContext(ctx, entityURL, params, &deleted)
 	return &deleted, resp, body, err
 }
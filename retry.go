@@ -0,0 +1,226 @@
+package wordpress
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultRetryMinBackoff = 500 * time.Millisecond
+	DefaultRetryMaxBackoff = 30 * time.Second
+)
+
+// DefaultRetryStatusCodes are the response status codes retried when
+// RetryPolicy.StatusCodes is empty.
+var DefaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy controls how failed requests are retried. Idempotent GET
+// requests are retried by default; requests that create, update or delete
+// content are only retried when RetryMutations is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values lower than 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff and MaxBackoff bound the exponential backoff between
+	// attempts. Each wait is jittered between half and all of the computed
+	// delay.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// RetryMutations enables retrying POST, PUT and DELETE requests.
+	RetryMutations bool
+
+	// StatusCodes lists the response status codes to retry. Defaults to
+	// DefaultRetryStatusCodes.
+	StatusCodes []int
+
+	// OnRetry, if set, is called before each retry with the number of the
+	// upcoming attempt and the response or error that caused it.
+	OnRetry func(attempt int, resp *http.Response, err error)
+}
+
+type retryCountKey struct{}
+
+// RetryCount returns the number of times the request that produced resp was
+// retried before resp was received.
+func RetryCount(resp *http.Response) int {
+	if resp == nil || resp.Request == nil {
+		return 0
+	}
+	if retries, ok := resp.Request.Context().Value(retryCountKey{}).(*int); ok {
+		return *retries
+	}
+	return 0
+}
+
+func (policy *RetryPolicy) enabled(req *http.Request) bool {
+	if policy == nil || policy.MaxAttempts < 2 {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// body cannot be replayed
+		return false
+	}
+	return policy.RetryMutations || isIdempotent(req)
+}
+
+func (policy *RetryPolicy) retryStatus(code int) bool {
+	codes := policy.StatusCodes
+	if len(codes) == 0 {
+		codes = DefaultRetryStatusCodes
+	}
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry (1-based).
+func (policy *RetryPolicy) backoff(retry int) time.Duration {
+	min, max := policy.MinBackoff, policy.MaxBackoff
+	if min <= 0 {
+		min = DefaultRetryMinBackoff
+	}
+	if max <= 0 {
+		max = DefaultRetryMaxBackoff
+	}
+	delay := min
+	for i := 1; i < retry && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
+
+func (policy *RetryPolicy) maxBackoff() time.Duration {
+	if policy.MaxBackoff <= 0 {
+		return DefaultRetryMaxBackoff
+	}
+	return policy.MaxBackoff
+}
+
+// isIdempotent reports whether req is a read-only request. Updates and
+// deletes are sent with X-HTTP-Method-Override and are not idempotent here.
+func isIdempotent(req *http.Request) bool {
+	if req.Header.Get("X-HTTP-Method-Override") != "" {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// retryAfter parses the Retry-After header, in either delay-seconds or
+// HTTP-date form.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// send executes req, retrying it according to the client's RetryPolicy, and
+// returns the final response with its body already read.
+func (client *Client) send(req *http.Request) (*http.Response, []byte, error) {
+	policy := client.options.Retry
+	retries := 0
+	req = req.WithContext(context.WithValue(req.Context(), retryCountKey{}, &retries))
+	retry := policy.enabled(req)
+
+	for {
+		resp, err := client.handler(req)
+		var body []byte
+		if resp != nil && resp.Body != nil {
+			// a middleware may fail while still returning the response, whose
+			// body must be closed all the same to release the connection
+			var readErr error
+			body, readErr = ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err == nil && readErr != nil {
+				return resp, body, readErr
+			}
+		}
+		if !retry || retries+1 >= policy.MaxAttempts {
+			return resp, body, err
+		}
+
+		var delay time.Duration
+		switch {
+		case err != nil:
+			if req.Context().Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				return resp, body, err
+			}
+			delay = policy.backoff(retries + 1)
+		case policy.retryStatus(resp.StatusCode):
+			var ok bool
+			delay, ok = retryAfter(resp)
+			if !ok {
+				delay = policy.backoff(retries + 1)
+			} else if delay > policy.maxBackoff() {
+				// the server asked us to wait longer than we are willing to
+				return resp, body, err
+			}
+		default:
+			return resp, body, err
+		}
+
+		retries++
+		client.logger.LogAttrs(req.Context(), slog.LevelInfo, "wordpress retry",
+			slog.String("method", requestMethod(req)),
+			slog.String("url", redactURL(req.URL)),
+			slog.Int("attempt", retries+1),
+			slog.Duration("delay", delay),
+		)
+		if policy.OnRetry != nil {
+			policy.OnRetry(retries+1, resp, err)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			// the last response is kept for inspection, but the error must
+			// report the cancellation rather than the retryable status
+			timer.Stop()
+			return resp, body, req.Context().Err()
+		case <-timer.C:
+		}
+
+		next := req.Clone(req.Context())
+		if req.GetBody != nil {
+			next.Body, err = req.GetBody()
+			if err != nil {
+				return resp, body, err
+			}
+		}
+		req = next
+	}
+}
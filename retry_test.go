@@ -0,0 +1,194 @@
+package wordpress_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func initTestRetryClient(t *testing.T, policy *wordpress.RetryPolicy, handler http.HandlerFunc) *wordpress.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return wordpress.NewClient(&wordpress.Options{
+		BaseAPIURL: server.URL,
+		Retry:      policy,
+	})
+}
+
+func TestRetry_GetRetriedUntilSuccess(t *testing.T) {
+	var calls int32
+	retried := 0
+	wp := initTestRetryClient(t, &wordpress.RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+		OnRetry: func(attempt int, resp *http.Response, err error) {
+			retried++
+		},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id":1}`))
+	})
+
+	post, resp, _, err := wp.Posts().Get(1, nil)
+	if err != nil {
+		t.Errorf("Should not return error: %v", err.Error())
+	}
+	if post.ID != 1 {
+		t.Errorf("Expected post 1, got %v", post.ID)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 attempts, got %v", calls)
+	}
+	if wordpress.RetryCount(resp) != 2 {
+		t.Errorf("Expected retry count 2, got %v", wordpress.RetryCount(resp))
+	}
+	if retried != 2 {
+		t.Errorf("Expected OnRetry to be called twice, got %v", retried)
+	}
+}
+
+func TestRetry_GivesUpAfterMaxAttempts(t *testing.T) {
+	var calls int32
+	wp := initTestRetryClient(t, &wordpress.RetryPolicy{
+		MaxAttempts: 2,
+		MinBackoff:  time.Millisecond,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, resp, _, err := wp.Posts().List(nil)
+	if err == nil {
+		t.Errorf("Should return error")
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Expected 429 TooManyRequests, got %v", resp.Status)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 attempts, got %v", calls)
+	}
+}
+
+func TestRetry_HonorsRetryAfter(t *testing.T) {
+	var calls int32
+	var first time.Time
+	var elapsed time.Duration
+	wp := initTestRetryClient(t, &wordpress.RetryPolicy{
+		MaxAttempts: 2,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Second,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		elapsed = time.Since(first)
+		w.Write([]byte(`[]`))
+	})
+
+	_, _, _, err := wp.Posts().List(nil)
+	if err != nil {
+		t.Errorf("Should not return error: %v", err.Error())
+	}
+	if elapsed < time.Second {
+		t.Errorf("Expected retry after at least 1s, got %v", elapsed)
+	}
+}
+
+func TestRetry_MutationsNotRetriedByDefault(t *testing.T) {
+	var calls int32
+	wp := initTestRetryClient(t, &wordpress.RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	p := factoryPost()
+	_, _, _, err := wp.Posts().Create(&p)
+	if err == nil {
+		t.Errorf("Should return error")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 attempt, got %v", calls)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	_, _, _, err = wp.Posts().Delete(1, nil)
+	if err == nil {
+		t.Errorf("Should return error")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 attempt, got %v", calls)
+	}
+}
+
+func TestRetry_MutationsOptIn(t *testing.T) {
+	var calls int32
+	wp := initTestRetryClient(t, &wordpress.RetryPolicy{
+		MaxAttempts:    3,
+		MinBackoff:     time.Millisecond,
+		RetryMutations: true,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		var p wordpress.Post
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil || p.Slug != "test-posts-create" {
+			t.Errorf("Request body should be replayed on every attempt")
+		}
+		if atomic.AddInt32(&calls, 1) < 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":10}`))
+	})
+
+	p := factoryPost()
+	created, _, _, err := wp.Posts().Create(&p)
+	if err != nil {
+		t.Errorf("Should not return error: %v", err.Error())
+	}
+	if created.ID != 10 {
+		t.Errorf("Expected post 10, got %v", created.ID)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 attempts, got %v", calls)
+	}
+}
+
+func TestRetry_CancelledDuringBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	wp := initTestRetryClient(t, &wordpress.RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Minute,
+		MaxBackoff:  time.Minute,
+		OnRetry: func(attempt int, resp *http.Response, err error) {
+			cancel()
+		},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, resp, _, err := wp.Posts().GetContext(ctx, 1, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected the last response to be returned, got %v", resp)
+	}
+}
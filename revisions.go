@@ -1,6 +1,7 @@
 package wordpress
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -33,22 +34,31 @@ type RevisionsCollection struct {
 }
 
 func (col *RevisionsCollection) List(params interface{}) ([]Revision, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *RevisionsCollection) ListContext(ctx context.Context, params interface{}) ([]Revision, *http.Response, []byte, error) {
 	var revisions []Revision
-	resp, body, err := col.client.List(col.url, params, &revisions)
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &revisions)
 	return revisions, resp, body, err
 }
 
 func (col *RevisionsCollection) Get(id int, params interface{}) (*Revision, *http.Response, []byte, error) {
+	return col.GetContext(context.Background(), id, params)
+}
+func (col *RevisionsCollection) GetContext(ctx context.Context, id int, params interface{}) (*Revision, *http.Response, []byte, error) {
 	var revision Revision
 	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Get(entityURL, params, &revision)
+	resp, body, err := col.client.GetContext(ctx, entityURL, params, &revision)
 	return &revision, resp, body, err
 }
 
 // TODO: file an issue for inconsistent response
 func (col *RevisionsCollection) Delete(id int, params interface{}) (bool, *http.Response, []byte, error) {
+	return col.DeleteContext(context.Background(), id, params)
+}
+func (col *RevisionsCollection) DeleteContext(ctx context.Context, id int, params interface{}) (bool, *http.Response, []byte, error) {
 	var response bool
 	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Delete(entityURL, "force=true", &response)
+	resp, body, err := col.client.DeleteContext(ctx, entityURL, "force=true", &response)
 	return response, resp, body, err
 }
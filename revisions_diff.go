@@ -0,0 +1,276 @@
+package wordpress
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// parentURL returns the URL of the post or page the revisions belong to.
+func (col *RevisionsCollection) parentURL() string {
+	return strings.TrimSuffix(col.url, "/"+CollectionRevisions)
+}
+
+// Restore writes the title, content and excerpt of the revision id back to
+// its parent post or page, as restoring it in the admin does. It returns the
+// restored revision, with the response of the parent update. When the
+// collection was reached from a Post or Page, that entity is refreshed with
+// the updated parent.
+func (col *RevisionsCollection) Restore(id int) (*Revision, *http.Response, []byte, error) {
+	return col.RestoreContext(context.Background(), id)
+}
+func (col *RevisionsCollection) RestoreContext(ctx context.Context, id int) (*Revision, *http.Response, []byte, error) {
+	revision, resp, body, err := col.GetContext(ctx, id, ListParams{Context: ContextEdit})
+	if err != nil {
+		return nil, resp, body, err
+	}
+	content := map[string]string{
+		"title":   revision.Title.Raw,
+		"content": revision.Content.Raw,
+		"excerpt": revision.Excerpt.Raw,
+	}
+	var updated interface{} = &map[string]interface{}{}
+	if col.parent != nil {
+		updated = col.parent
+	}
+	resp, body, err = col.client.UpdateContext(ctx, col.parentURL(), content, updated)
+	return revision, resp, body, err
+}
+
+// Diff compares the revision from with the revision to. Both are fetched
+// with `context=edit`, so that their raw text is compared.
+func (col *RevisionsCollection) Diff(from int, to int) (*RevisionDiff, error) {
+	return col.DiffContext(context.Background(), from, to)
+}
+func (col *RevisionsCollection) DiffContext(ctx context.Context, from int, to int) (*RevisionDiff, error) {
+	fromRevision, _, _, err := col.GetContext(ctx, from, ListParams{Context: ContextEdit})
+	if err != nil {
+		return nil, err
+	}
+	toRevision, _, _, err := col.GetContext(ctx, to, ListParams{Context: ContextEdit})
+	if err != nil {
+		return nil, err
+	}
+	return DiffRevisions(fromRevision, toRevision), nil
+}
+
+// DiffCurrent compares the revision id with the current state of its parent
+// post or page, as a Revision.
+func (col *RevisionsCollection) DiffCurrent(id int) (*RevisionDiff, error) {
+	return col.DiffCurrentContext(context.Background(), id)
+}
+func (col *RevisionsCollection) DiffCurrentContext(ctx context.Context, id int) (*RevisionDiff, error) {
+	revision, _, _, err := col.GetContext(ctx, id, ListParams{Context: ContextEdit})
+	if err != nil {
+		return nil, err
+	}
+	var current Revision
+	if _, _, err := col.client.GetContext(ctx, col.parentURL(), ListParams{Context: ContextEdit}, &current); err != nil {
+		return nil, err
+	}
+	return DiffRevisions(revision, &current), nil
+}
+
+// RevisionDiff is the difference between two revisions.
+type RevisionDiff struct {
+	From *Revision
+	To   *Revision
+	// Fields are the changed fields, among "title", "content" and "excerpt".
+	Fields []FieldDiff
+}
+
+// Changed reports whether any field differs.
+func (diff *RevisionDiff) Changed() bool {
+	return len(diff.Fields) > 0
+}
+
+// Field returns the difference of the field name, or nil if it is unchanged.
+func (diff *RevisionDiff) Field(name string) *FieldDiff {
+	for i := range diff.Fields {
+		if diff.Fields[i].Field == name {
+			return &diff.Fields[i]
+		}
+	}
+	return nil
+}
+
+// FieldDiff is the difference of one text field between two revisions.
+type FieldDiff struct {
+	Field string
+	From  string
+	To    string
+	// Lines is the line-oriented diff of From and To.
+	Lines []DiffLine
+}
+
+// String formats the diff one line per line, prefixed with " ", "-" or "+".
+func (diff FieldDiff) String() string {
+	var b strings.Builder
+	for _, line := range diff.Lines {
+		fmt.Fprintf(&b, "%v%v\n", line.Op, line.Text)
+	}
+	return b.String()
+}
+
+type DiffOp int
+
+const (
+	DiffEqual DiffOp = iota
+	DiffDelete
+	DiffInsert
+)
+
+func (op DiffOp) String() string {
+	switch op {
+	case DiffDelete:
+		return "-"
+	case DiffInsert:
+		return "+"
+	}
+	return " "
+}
+
+// DiffLine is a line of a FieldDiff.
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// DiffRevisions compares the title, content and excerpt of two revisions.
+// The raw text is compared, or the rendered one when the raw text of both is
+// missing, as without `context=edit`.
+func DiffRevisions(from *Revision, to *Revision) *RevisionDiff {
+	diff := &RevisionDiff{From: from, To: to}
+	fields := []struct {
+		name     string
+		from, to RenderedText
+	}{
+		{"title", from.Title, to.Title},
+		{"content", from.Content, to.Content},
+		{"excerpt", from.Excerpt, to.Excerpt},
+	}
+	for _, field := range fields {
+		fromText, toText := field.from.Raw, field.to.Raw
+		if fromText == "" && toText == "" {
+			fromText, toText = field.from.Rendered, field.to.Rendered
+		}
+		if fromText == toText {
+			continue
+		}
+		diff.Fields = append(diff.Fields, FieldDiff{
+			Field: field.name,
+			From:  fromText,
+			To:    toText,
+			Lines: diffLines(splitLines(fromText), splitLines(toText)),
+		})
+	}
+	return diff
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// maxDiffEdits bounds the work of diffLines, past which the texts are
+// reported as entirely replaced.
+const maxDiffEdits = 1024
+
+// diffLines returns a shortest edit script from a to b, using Myers'
+// algorithm after trimming the common prefix and suffix.
+func diffLines(a, b []string) []DiffLine {
+	var prefix, suffix []DiffLine
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		prefix = append(prefix, DiffLine{DiffEqual, a[0]})
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		suffix = append([]DiffLine{{DiffEqual, a[len(a)-1]}}, suffix...)
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+
+	lines := append(prefix, myers(a, b)...)
+	return append(lines, suffix...)
+}
+
+func myers(a, b []string) []DiffLine {
+	n, m := len(a), len(b)
+	max := n + m
+	if max > maxDiffEdits {
+		max = maxDiffEdits
+	}
+	// v[offset+k] is the furthest x reached on diagonal k = x - y. trace[d]
+	// holds v for diagonals -d-1..d+1 before step d.
+	offset := max + 1
+	v := make([]int, 2*max+3)
+	var trace [][]int
+	for d := 0; d <= max; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(a, b, trace)
+			}
+		}
+	}
+
+	// too many edits
+	lines := make([]DiffLine, 0, n+m)
+	for _, line := range a {
+		lines = append(lines, DiffLine{DiffDelete, line})
+	}
+	for _, line := range b {
+		lines = append(lines, DiffLine{DiffInsert, line})
+	}
+	return lines
+}
+
+func backtrack(a, b []string, trace [][]int) []DiffLine {
+	var reversed []DiffLine
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d >= 0; d-- {
+		// trace[d][d+1+k] is v[k]
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[d+k] < v[d+k+2]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[d+1+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			reversed = append(reversed, DiffLine{DiffEqual, a[x-1]})
+			x, y = x-1, y-1
+		}
+		if d > 0 {
+			if x == prevX {
+				reversed = append(reversed, DiffLine{DiffInsert, b[y-1]})
+			} else {
+				reversed = append(reversed, DiffLine{DiffDelete, a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	lines := make([]DiffLine, len(reversed))
+	for i, line := range reversed {
+		lines[len(reversed)-1-i] = line
+	}
+	return lines
+}
@@ -0,0 +1,177 @@
+package wordpress_test
+
+import (
+	"encoding/json"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func revisionWithContent(content string) *wordpress.Revision {
+	return &wordpress.Revision{Content: wordpress.RenderedText{Raw: content}}
+}
+
+func TestDiffRevisions_Content(t *testing.T) {
+	from := revisionWithContent("<p>One</p>\n<p>Two</p>\n<p>Three</p>\n")
+	to := revisionWithContent("<p>One</p>\n<p>2</p>\n<p>Three</p>\n<p>Four</p>\n")
+	from.Title.Raw, to.Title.Raw = "Same", "Same"
+
+	diff := wordpress.DiffRevisions(from, to)
+	if len(diff.Fields) != 1 || diff.Field("title") != nil {
+		t.Fatalf("Only content should differ: %+v", diff.Fields)
+	}
+	expected := " <p>One</p>\n-<p>Two</p>\n+<p>2</p>\n <p>Three</p>\n+<p>Four</p>\n"
+	if got := diff.Field("content").String(); got != expected {
+		t.Errorf("Unexpected diff:\n%v\nexpected:\n%v", got, expected)
+	}
+	if wordpress.DiffRevisions(from, from).Changed() {
+		t.Errorf("Same revision should not differ")
+	}
+}
+
+func TestDiffRevisions_RenderedFallback(t *testing.T) {
+	from := &wordpress.Revision{Title: wordpress.RenderedText{Rendered: "Old"}}
+	to := &wordpress.Revision{Title: wordpress.RenderedText{Rendered: "New"}}
+
+	title := wordpress.DiffRevisions(from, to).Field("title")
+	if title == nil || title.String() != "-Old\n+New\n" {
+		t.Errorf("Rendered text should be compared without raw text: %v", title)
+	}
+}
+
+// lcs returns the length of the longest common subsequence of a and b.
+func lcs(a, b []string) int {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+	return lengths[0][0]
+}
+
+func TestDiffRevisions_ShortestEdit(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	randomLines := func() []string {
+		lines := make([]string, random.IntN(12))
+		for i := range lines {
+			lines[i] = string(rune('a' + random.IntN(4)))
+		}
+		return lines
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := randomLines(), randomLines()
+		diff := wordpress.DiffRevisions(revisionWithContent(strings.Join(a, "\n")), revisionWithContent(strings.Join(b, "\n")))
+		content := diff.Field("content")
+		if content == nil {
+			if strings.Join(a, "\n") != strings.Join(b, "\n") {
+				t.Fatalf("Missing diff of %v and %v", a, b)
+			}
+			continue
+		}
+
+		var from, to []string
+		edits := 0
+		for _, line := range content.Lines {
+			switch line.Op {
+			case wordpress.DiffEqual:
+				from, to = append(from, line.Text), append(to, line.Text)
+			case wordpress.DiffDelete:
+				from = append(from, line.Text)
+				edits++
+			case wordpress.DiffInsert:
+				to = append(to, line.Text)
+				edits++
+			}
+		}
+		if strings.Join(from, "\n") != strings.Join(a, "\n") || strings.Join(to, "\n") != strings.Join(b, "\n") {
+			t.Fatalf("Diff of %v and %v does not rebuild them: %v", a, b, content.Lines)
+		}
+		if expected := len(a) + len(b) - 2*lcs(a, b); edits != expected {
+			t.Fatalf("Diff of %v and %v has %v edits, expected %v", a, b, edits, expected)
+		}
+	}
+}
+
+func TestRevisionsRestore(t *testing.T) {
+	var sent map[string]string
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /posts/5":
+			w.Write([]byte(`{"id":5,"title":{"raw":"Current"},"content":{"raw":"new\n"}}`))
+		case "GET /posts/5/revisions/9":
+			if r.URL.Query().Get("context") != "edit" {
+				t.Errorf("Revision should be fetched with context=edit")
+			}
+			w.Write([]byte(`{"id":9,"parent":5,"title":{"raw":"Old"},"content":{"raw":"old\n"},"excerpt":{"raw":""}}`))
+		case "POST /posts/5":
+			if r.Header.Get("X-HTTP-Method-Override") != "PUT" {
+				t.Errorf("Restore should update the parent")
+			}
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.Write([]byte(`{"id":5,"title":{"raw":"Old","rendered":"Old"},"content":{"raw":"old\n"}}`))
+		default:
+			t.Errorf("Unexpected request: %v %v", r.Method, r.URL.Path)
+		}
+	})
+
+	post, _, _, err := wp.Posts().Get(5, nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+
+	diff, err := post.Revisions().DiffCurrent(9)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if diff.Field("title").String() != "-Old\n+Current\n" || diff.Field("content").String() != "-old\n+new\n" {
+		t.Errorf("Unexpected diff: %+v", diff.Fields)
+	}
+
+	revision, _, _, err := post.Revisions().Restore(9)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if revision.ID != 9 || sent["title"] != "Old" || sent["content"] != "old\n" {
+		t.Errorf("Unexpected restore: %+v, %v", revision, sent)
+	}
+	if post.Title.Rendered != "Old" {
+		t.Errorf("Parent post should be refreshed: %+v", post.Title)
+	}
+}
+
+func TestRevisionsDiff(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pages/3/revisions/1":
+			w.Write([]byte(`{"id":1,"excerpt":{"raw":"a"}}`))
+		case "/pages/3/revisions/2":
+			w.Write([]byte(`{"id":2,"excerpt":{"raw":"b"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	diff, err := wp.Pages().Entity(3).Revisions().Diff(1, 2)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if diff.From.ID != 1 || diff.To.ID != 2 || len(diff.Fields) != 1 || diff.Fields[0].Field != "excerpt" {
+		t.Errorf("Unexpected diff: %+v", diff)
+	}
+
+	if _, err := wp.Pages().Entity(3).Revisions().Diff(1, 4); !wordpress.IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,232 @@
+package wordpress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	SearchTypePost       = "post"
+	SearchTypeTerm       = "term"
+	SearchTypePostFormat = "post-format"
+
+	SearchSubtypeAny = "any"
+)
+
+// SearchResult is a result of `/search`: a post of any type, a term or a
+// post format, telling which by Type and Subtype. Use Resolve, or one of the
+// typed Resolve methods, to fetch the entity itself.
+type SearchResult struct {
+	collection *SearchCollection `json:"-"`
+
+	// ID is the ID of the post or term. It is 0 for post formats, whose
+	// slug is in Format.
+	ID     int    `json:"id,omitempty"`
+	Format string `json:"-"`
+	Title  string `json:"title,omitempty"`
+	URL    string `json:"url,omitempty"`
+	// Type is SearchTypePost, SearchTypeTerm or SearchTypePostFormat.
+	Type string `json:"type,omitempty"`
+	// Subtype is the post type or taxonomy slug, e.g. "page" or "post_tag".
+	Subtype string `json:"subtype,omitempty"`
+	Links   Links  `json:"_links,omitempty"`
+}
+
+func (result *SearchResult) UnmarshalJSON(data []byte) error {
+	type fields SearchResult
+	decoded := struct {
+		*fields
+		ID json.RawMessage `json:"id"`
+	}{fields: (*fields)(result)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if len(decoded.ID) > 0 && decoded.ID[0] == '"' {
+		return json.Unmarshal(decoded.ID, &result.Format)
+	}
+	if len(decoded.ID) > 0 && string(decoded.ID) != "null" {
+		return json.Unmarshal(decoded.ID, &result.ID)
+	}
+	return nil
+}
+
+// SearchListParams are the query parameters of SearchCollection.List. Offset,
+// Order and OrderBy are not supported by the endpoint.
+type SearchListParams struct {
+	ListParams
+
+	// Type is one of SearchTypePost (the default), SearchTypeTerm or
+	// SearchTypePostFormat.
+	Type string `url:"type,omitempty"`
+	// Subtype limits the results to post types or taxonomies, all of those
+	// of Type by default.
+	Subtype []string `url:"subtype,omitempty"`
+}
+
+// SearchCollection is the `/search` endpoint, searching posts of every type,
+// terms or post formats at once.
+type SearchCollection struct {
+	client *Client
+	url    string
+}
+
+// URL returns the URL of the search endpoint.
+func (col *SearchCollection) URL() string {
+	return col.url
+}
+
+func (col *SearchCollection) bind(result *SearchResult) {
+	result.collection = col
+}
+
+func (col *SearchCollection) List(params interface{}) ([]SearchResult, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *SearchCollection) ListContext(ctx context.Context, params interface{}) ([]SearchResult, *http.Response, []byte, error) {
+	var results []SearchResult
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &results)
+	for i := range results {
+		col.bind(&results[i])
+	}
+	return results, resp, body, err
+}
+
+// All returns an iterator over all results matching params, fetching further
+// pages as needed. Iteration stops at the first error.
+func (col *SearchCollection) All(ctx context.Context, params interface{}) iter.Seq2[SearchResult, error] {
+	return paginate[SearchResult](ctx, col.client, col.url, params, col.bind)
+}
+
+// collectionURL returns the URL of the collection holding the result, from
+// the route of its self link, or else from the rest_base of its post type or
+// taxonomy. Only the route is taken from the link: it is built from the site
+// URL WordPress advertises, which may differ from Options.BaseAPIURL.
+func (result *SearchResult) collectionURL(ctx context.Context) (*Client, string, error) {
+	if result.collection == nil {
+		return nil, "", fmt.Errorf("wordpress: search result %v was not returned by the API", result.ID)
+	}
+	client := result.collection.client
+	if result.ID == 0 {
+		return nil, "", fmt.Errorf("wordpress: search result of type %v cannot be resolved", result.Type)
+	}
+
+	if namespace, restBase, ok := selfRoute(result.Links.Href("self"), result.ID); ok {
+		return client, client.namespaceURL(namespace, restBase), nil
+	}
+	switch result.Type {
+	case SearchTypePost:
+		postType, _, _, err := client.Types().GetContext(ctx, result.Subtype, nil)
+		if err != nil {
+			return nil, "", fmt.Errorf("wordpress: resolving post type %v: %w", result.Subtype, err)
+		}
+		return client, client.namespaceURL(postType.RestNamespace, postType.RestBase), nil
+	case SearchTypeTerm:
+		taxonomy, _, _, err := client.Taxonomies().GetContext(ctx, result.Subtype, nil)
+		if err != nil {
+			return nil, "", fmt.Errorf("wordpress: resolving taxonomy %v: %w", result.Subtype, err)
+		}
+		return client, client.namespaceURL(taxonomy.RestNamespace, taxonomy.RestBase), nil
+	}
+	return nil, "", fmt.Errorf("wordpress: search result of type %v cannot be resolved", result.Type)
+}
+
+// selfRoute splits the route of the self link of the entity id, e.g.
+// `https://example.com/wp-json/wp/v2/posts/42`, into its namespace and the
+// rest_base of its collection.
+func selfRoute(self string, id int) (namespace string, restBase string, ok bool) {
+	u, err := url.Parse(self)
+	if err != nil || self == "" {
+		return "", "", false
+	}
+	route := u.Query().Get("rest_route")
+	if route == "" {
+		if _, route, ok = strings.Cut(u.Path, "/wp-json/"); !ok {
+			return "", "", false
+		}
+	}
+	route, ok = strings.CutSuffix(strings.Trim(route, "/"), "/"+strconv.Itoa(id))
+	if !ok {
+		return "", "", false
+	}
+	parts := strings.SplitN(route, "/", 3)
+	if len(parts) != 3 {
+		return "", "", false
+	}
+	return parts[0] + "/" + parts[1], parts[2], true
+}
+
+// Resolve fetches the entity of the result: a *Page for pages, a *Post for
+// posts of other types, including custom ones, or a *Term.
+func (result *SearchResult) Resolve() (interface{}, error) {
+	return result.ResolveContext(context.Background())
+}
+func (result *SearchResult) ResolveContext(ctx context.Context) (interface{}, error) {
+	switch {
+	case result.Type == SearchTypeTerm:
+		return result.ResolveTermContext(ctx)
+	case result.Type == SearchTypePost && result.Subtype == PostTypePage:
+		return result.ResolvePageContext(ctx)
+	case result.Type == SearchTypePost:
+		return result.ResolvePostContext(ctx)
+	}
+	return nil, fmt.Errorf("wordpress: search result of type %v cannot be resolved", result.Type)
+}
+
+// ResolvePost fetches the post of the result, of any post type.
+func (result *SearchResult) ResolvePost() (*Post, error) {
+	return result.ResolvePostContext(context.Background())
+}
+func (result *SearchResult) ResolvePostContext(ctx context.Context) (*Post, error) {
+	return ResolveSearchResult[Post](ctx, result)
+}
+
+// ResolvePage fetches the page of the result.
+func (result *SearchResult) ResolvePage() (*Page, error) {
+	return result.ResolvePageContext(context.Background())
+}
+func (result *SearchResult) ResolvePageContext(ctx context.Context) (*Page, error) {
+	client, url, err := result.collectionURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, _, _, err := newPagesCollection(client, url).GetContext(ctx, result.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// ResolveTerm fetches the term of the result.
+func (result *SearchResult) ResolveTerm() (*Term, error) {
+	return result.ResolveTermContext(context.Background())
+}
+func (result *SearchResult) ResolveTermContext(ctx context.Context) (*Term, error) {
+	return ResolveSearchResult[Term](ctx, result)
+}
+
+// ResolveSearchResult fetches the entity of the result decoded into T, such
+// as a struct embedding Post for a custom post type. Entities embedding Post
+// get their sub-collections wired as with PostTypeOf.
+func ResolveSearchResult[T any](ctx context.Context, result *SearchResult) (*T, error) {
+	client, url, err := result.collectionURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	posts := newPostsCollection(client, url)
+	col := newCollection(client, url, func(entity *T) {
+		if binder, ok := any(entity).(postBinder); ok {
+			binder.setCollection(posts)
+		}
+	})
+	entity, _, _, err := col.GetContext(ctx, result.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
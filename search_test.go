@@ -0,0 +1,139 @@
+package wordpress_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestSearch_ResolveResults(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/search":
+			if r.URL.Query().Get("type") == wordpress.SearchTypePostFormat {
+				w.Write([]byte(`[{"id":"aside","title":"Aside","url":"https://example.com/type/aside/","type":"post-format"}]`))
+				return
+			}
+			if r.URL.RawQuery != "search=release&subtype=post%2Cpage%2Cevent&type=post" {
+				t.Errorf("Unexpected query: %v", r.URL.RawQuery)
+			}
+			w.Write([]byte(`[
+				{"id":42,"title":"Release","type":"post","subtype":"post","_links":{"self":[{"embeddable":true,"href":"https://example.com/wp-json/wp/v2/posts/42"}]}},
+				{"id":12,"title":"Releases","type":"post","subtype":"page","_links":{"self":[{"embeddable":true,"href":"https://example.com/?rest_route=/wp/v2/pages/12"}]}},
+				{"id":7,"title":"Release party","type":"post","subtype":"event"}
+			]`))
+		case "/posts/42":
+			w.Write([]byte(`{"id":42,"type":"post"}`))
+		case "/pages/12":
+			w.Write([]byte(`{"id":12,"type":"page","parent":3}`))
+		case "/types/event":
+			w.Write([]byte(`{"slug":"event","rest_base":"events","rest_namespace":"wp/v2"}`))
+		case "/events/7":
+			w.Write([]byte(`{"id":7,"type":"event","venue":"Main hall"}`))
+		default:
+			t.Errorf("Unexpected request: %v", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	results, _, _, err := wp.Search().List(&wordpress.SearchListParams{
+		ListParams: wordpress.ListParams{Search: "release"},
+		Type:       wordpress.SearchTypePost,
+		Subtype:    []string{"post", "page", "event"},
+	})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if len(results) != 3 {
+		t.Fatalf("Unexpected results: %+v", results)
+	}
+
+	post, err := results[0].Resolve()
+	if p, ok := post.(*wordpress.Post); err != nil || !ok || p.ID != 42 || p.Revisions() == nil {
+		t.Errorf("Unexpected post: %#v, %v", post, err)
+	}
+	page, err := results[1].Resolve()
+	if p, ok := page.(*wordpress.Page); err != nil || !ok || p.Parent != 3 || p.Revisions() == nil {
+		t.Errorf("Unexpected page: %#v, %v", page, err)
+	}
+
+	type event struct {
+		wordpress.Post
+		Venue string `json:"venue"`
+	}
+	custom, err := wordpress.ResolveSearchResult[event](context.Background(), &results[2])
+	if err != nil || custom.ID != 7 || custom.Venue != "Main hall" {
+		t.Errorf("Custom type should be resolved from its rest_base: %+v, %v", custom, err)
+	}
+
+	formats, _, _, err := wp.Search().List(&wordpress.SearchListParams{Type: wordpress.SearchTypePostFormat})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if formats[0].ID != 0 || formats[0].Format != "aside" {
+		t.Errorf("Post format should be decoded: %+v", formats[0])
+	}
+	if _, err := formats[0].Resolve(); err == nil {
+		t.Errorf("Post formats cannot be resolved")
+	}
+}
+
+func TestSearch_ResolveUsesClientHost(t *testing.T) {
+	advertised := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Request sent to the advertised host: %v", r.URL)
+	}))
+	defer advertised.Close()
+	var auth string
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/search":
+			w.Write([]byte(`[{"id":42,"title":"Release","type":"post","subtype":"post","_links":{"self":[{"href":"` + advertised.URL + `/wp-json/wp/v2/posts/42"}]}}]`))
+		case "/posts/42":
+			auth = r.Header.Get("Authorization")
+			w.Write([]byte(`{"id":42,"type":"post"}`))
+		default:
+			t.Errorf("Unexpected request: %v", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	results, _, _, err := wp.Search().List(nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	post, err := results[0].ResolvePost()
+	if err != nil || post.ID != 42 {
+		t.Errorf("Unexpected post: %+v, %v", post, err)
+	}
+	if auth == "" {
+		t.Errorf("Credentials should be sent to the client host")
+	}
+}
+
+func TestSearch_ResolveTermByID(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/search":
+			w.Write([]byte(`[{"id":5,"title":"News","type":"term","subtype":"category"}]`))
+		case "/taxonomies/category":
+			w.Write([]byte(`{"slug":"category","rest_base":"categories"}`))
+		case "/categories/5":
+			w.Write([]byte(`{"id":5,"count":3,"name":"News","taxonomy":"category"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	for result, err := range wp.Search().All(context.Background(), "type=term") {
+		if err != nil {
+			t.Fatalf("Should not return error: %v", err.Error())
+		}
+		term, err := result.ResolveTerm()
+		if err != nil || term.Name != "News" || term.Count != 3 {
+			t.Errorf("Unexpected term: %+v, %v", term, err)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package wordpress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Settings are the site settings of `/settings`. Fields whose zero value is
+// meaningful, e.g. StartOfWeek 0 for Sunday, are pointers so that Update only
+// sends the ones that are set. URL and Email are not available on multisite
+// installs.
+//
+// Permalink settings are not exposed by the REST API.
+type Settings struct {
+	Title                string  `json:"title,omitempty"`
+	Description          *string `json:"description,omitempty"`
+	URL                  string  `json:"url,omitempty"`
+	Email                string  `json:"email,omitempty"`
+	Timezone             string  `json:"timezone,omitempty"`
+	DateFormat           string  `json:"date_format,omitempty"`
+	TimeFormat           string  `json:"time_format,omitempty"`
+	StartOfWeek          *int    `json:"start_of_week,omitempty"`
+	Language             string  `json:"language,omitempty"`
+	UseSmilies           *bool   `json:"use_smilies,omitempty"`
+	DefaultCategory      int     `json:"default_category,omitempty"`
+	DefaultPostFormat    string  `json:"default_post_format,omitempty"`
+	PostsPerPage         int     `json:"posts_per_page,omitempty"`
+	ShowOnFront          string  `json:"show_on_front,omitempty"`
+	PageOnFront          *int    `json:"page_on_front,omitempty"`
+	PageForPosts         *int    `json:"page_for_posts,omitempty"`
+	DefaultPingStatus    string  `json:"default_ping_status,omitempty"`
+	DefaultCommentStatus string  `json:"default_comment_status,omitempty"`
+	SiteLogo             *int    `json:"site_logo,omitempty"`
+	SiteIcon             *int    `json:"site_icon,omitempty"`
+	// Extra holds the settings registered by plugins and themes with
+	// show_in_rest, keyed by name. They are sent back on Update.
+	Extra map[string]interface{} `json:"-"`
+}
+
+func (entity *Settings) decodeRaw(raw []byte, known map[string]bool) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return
+	}
+	entity.Extra = map[string]interface{}{}
+	for name, value := range fields {
+		if !known[name] {
+			entity.Extra[name] = value
+		}
+	}
+}
+func (entity *Settings) encodeRaw() map[string]interface{} {
+	return entity.Extra
+}
+
+// DecodeExtra decodes the extra setting name into v, e.g. a struct matching
+// the schema a plugin registered.
+func (entity *Settings) DecodeExtra(name string, v interface{}) error {
+	value, ok := entity.Extra[name]
+	if !ok {
+		return fmt.Errorf("wordpress: setting %v not found", name)
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// SettingsCollection is the `/settings` endpoint. It requires the
+// manage_options capability.
+type SettingsCollection struct {
+	client *Client
+	url    string
+}
+
+func (col *SettingsCollection) Get() (*Settings, *http.Response, []byte, error) {
+	return col.GetContext(context.Background())
+}
+func (col *SettingsCollection) GetContext(ctx context.Context) (*Settings, *http.Response, []byte, error) {
+	var settings Settings
+	resp, body, err := col.client.GetContext(ctx, col.url, nil, &decodedEntity[Settings]{entity: &settings})
+	return &settings, resp, body, err
+}
+
+// Update sends the settings that are set, including Extra, and returns the
+// updated settings. Use UpdateFields to send settings by name.
+func (col *SettingsCollection) Update(settings *Settings) (*Settings, *http.Response, []byte, error) {
+	return col.UpdateContext(context.Background(), settings)
+}
+func (col *SettingsCollection) UpdateContext(ctx context.Context, settings *Settings) (*Settings, *http.Response, []byte, error) {
+	content, err := encodeEntity(settings)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return col.UpdateFieldsContext(ctx, content)
+}
+
+// UpdateFields updates the settings in fields, a map or struct of setting
+// names and values, leaving the others unchanged.
+func (col *SettingsCollection) UpdateFields(fields interface{}) (*Settings, *http.Response, []byte, error) {
+	return col.UpdateFieldsContext(context.Background(), fields)
+}
+func (col *SettingsCollection) UpdateFieldsContext(ctx context.Context, fields interface{}) (*Settings, *http.Response, []byte, error) {
+	var updated Settings
+	resp, body, err := col.client.UpdateContext(ctx, col.url, fields, &decodedEntity[Settings]{entity: &updated})
+	return &updated, resp, body, err
+}
@@ -0,0 +1,98 @@
+package wordpress_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestFixture_Settings(t *testing.T) {
+	wp, fixture := fixtureServerClient(t, "settings.json")
+
+	settings, _, _, err := wp.Settings().Get()
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	assertFixtureFields(t, fixture, settings, "woocommerce_currency", "seo_plugin_options")
+
+	if settings.Extra["woocommerce_currency"] != "EUR" || len(settings.Extra) != 2 {
+		t.Errorf("Plugin settings should be kept in Extra: %v", settings.Extra)
+	}
+	var seo struct {
+		Separator       string `json:"separator"`
+		NoindexArchives bool   `json:"noindex_archives"`
+	}
+	if err := settings.DecodeExtra("seo_plugin_options", &seo); err != nil || seo.Separator != "|" || !seo.NoindexArchives {
+		t.Errorf("Unexpected plugin settings: %+v, %v", seo, err)
+	}
+	if err := settings.DecodeExtra("missing", &seo); err == nil {
+		t.Errorf("Missing setting should return an error")
+	}
+}
+
+func TestSettingsUpdate(t *testing.T) {
+	var sent map[string]interface{}
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/settings" {
+			t.Errorf("Unexpected path: %v", r.URL.Path)
+		}
+		if r.Method == "POST" {
+			if r.Header.Get("X-HTTP-Method-Override") != "PUT" {
+				t.Errorf("Update should be sent as PUT")
+			}
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.Write([]byte(`{"title":"Renamed","posts_per_page":20,"start_of_week":0,"woocommerce_currency":"USD"}`))
+			return
+		}
+		w.Write([]byte(`{"title":"Example","description":"","start_of_week":1,"posts_per_page":10,"woocommerce_currency":"EUR"}`))
+	})
+
+	settings, _, _, err := wp.Settings().Get()
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	settings.Title = "Renamed"
+	sunday := 0
+	settings.StartOfWeek = &sunday
+	settings.Extra["woocommerce_currency"] = "USD"
+
+	updated, _, _, err := wp.Settings().Update(settings)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if sent["title"] != "Renamed" || sent["start_of_week"] != float64(0) || sent["woocommerce_currency"] != "USD" || sent["description"] != "" {
+		t.Errorf("Unexpected request: %v", sent)
+	}
+	if updated.Title != "Renamed" || updated.Extra["woocommerce_currency"] != "USD" {
+		t.Errorf("Unexpected updated settings: %+v", updated)
+	}
+
+	sent = nil
+	if _, _, _, err := wp.Settings().UpdateFields(map[string]int{"posts_per_page": 20}); err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if len(sent) != 1 || sent["posts_per_page"] != float64(20) {
+		t.Errorf("Only the given fields should be sent: %v", sent)
+	}
+}
+
+func TestSettingsUpdate_OnlySetFields(t *testing.T) {
+	var sent map[string]interface{}
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Write([]byte(`{"title":"Renamed","description":"Tagline","start_of_week":1,"use_smilies":true,"page_on_front":12}`))
+	})
+
+	updated, _, _, err := wp.Settings().Update(&wordpress.Settings{Title: "Renamed"})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if len(sent) != 1 || sent["title"] != "Renamed" {
+		t.Errorf("Only the set fields should be sent: %v", sent)
+	}
+	if *updated.Description != "Tagline" || *updated.StartOfWeek != 1 || !*updated.UseSmilies || *updated.PageOnFront != 12 {
+		t.Errorf("Unexpected updated settings: %+v", updated)
+	}
+}
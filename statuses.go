@@ -1,6 +1,7 @@
 package wordpress
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -27,14 +28,20 @@ type StatusesCollection struct {
 }
 
 func (col *StatusesCollection) List(params interface{}) (*Statuses, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *StatusesCollection) ListContext(ctx context.Context, params interface{}) (*Statuses, *http.Response, []byte, error) {
 	var statuses Statuses
-	resp, body, err := col.client.List(col.url, params, &statuses)
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &statuses)
 	return &statuses, resp, body, err
 }
 
 func (col *StatusesCollection) Get(slug string, params interface{}) (*Status, *http.Response, []byte, error) {
+	return col.GetContext(context.Background(), slug, params)
+}
+func (col *StatusesCollection) GetContext(ctx context.Context, slug string, params interface{}) (*Status, *http.Response, []byte, error) {
 	var entity Status
 	entityURL := fmt.Sprintf("%v/%v", col.url, slug)
-	resp, body, err := col.client.Get(entityURL, params, &entity)
+	resp, body, err := col.client.GetContext(ctx, entityURL, params, &entity)
 	return &entity, resp, body, err
 }
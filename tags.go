@@ -0,0 +1,7 @@
+package wordpress
+
+// TagsCollection is the `/tags` collection of terms of the `post_tag`
+// taxonomy.
+type TagsCollection struct {
+	*TaxonomyTermsCollection
+}
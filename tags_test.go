@@ -0,0 +1,88 @@
+package wordpress_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestTagsListForPost(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tags" || r.URL.Query().Get("post") != "10" {
+			t.Errorf("Unexpected request: %v", r.URL)
+		}
+		w.Write([]byte(`[{"id":7,"count":2,"name":"Go","slug":"go","taxonomy":"post_tag","meta":[]}]`))
+	})
+
+	tags, _, _, err := wp.Tags().List(&wordpress.TermListParams{Post: 10})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if len(tags) != 1 || tags[0].Taxonomy != "post_tag" || tags[0].Count != 2 {
+		t.Errorf("Unexpected tags: %+v", tags)
+	}
+}
+
+func TestTagsAssignToPost(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var post map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&post)
+		if fmt.Sprint(post["tags"]) != "[7 8]" || fmt.Sprint(post["categories"]) != "[1]" {
+			t.Errorf("Unexpected post body: %v", post)
+		}
+		w.Write([]byte(`{"id":10,"categories":[1],"tags":[7,8]}`))
+	})
+
+	updated, _, _, err := wp.Posts().Update(10, &wordpress.Post{
+		Categories: []int{1},
+		Tags:       []int{7, 8},
+	})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if len(updated.Tags) != 2 || updated.Categories[0] != 1 {
+		t.Errorf("Unexpected updated post: %+v", updated)
+	}
+}
+
+func TestTagsRemoveAllFromPost(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var post map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&post)
+		if tags, ok := post["tags"]; !ok || fmt.Sprint(tags) != "[]" {
+			t.Errorf("An empty tag list should be sent: %v", post)
+		}
+		if _, ok := post["categories"]; ok {
+			t.Errorf("Nil categories should be omitted: %v", post)
+		}
+		w.Write([]byte(`{"id":10,"categories":[1],"tags":[]}`))
+	})
+
+	updated, _, _, err := wp.Posts().Update(10, &wordpress.Post{Tags: []int{}})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if updated.Tags == nil || len(updated.Tags) != 0 {
+		t.Errorf("Unexpected updated post: %+v", updated)
+	}
+}
+
+func TestTagsDeleteForces(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("force") != "true" {
+			t.Errorf("Tags should be deleted with force=true")
+		}
+		w.Write([]byte(`{"deleted":true,"previous":{"id":7,"name":"Go"}}`))
+	})
+
+	deleted, _, _, err := wp.Tags().Delete(7)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if deleted.ID != 7 {
+		t.Errorf("Unexpected deleted tag: %+v", deleted)
+	}
+}
@@ -1,18 +1,31 @@
 package wordpress
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
 
+type TaxonomyVisibility struct {
+	Public            bool `json:"public,omitempty"`
+	PubliclyQueryable bool `json:"publicly_queryable,omitempty"`
+	ShowAdminColumn   bool `json:"show_admin_column,omitempty"`
+	ShowInNavMenus    bool `json:"show_in_nav_menus,omitempty"`
+	ShowInQuickEdit   bool `json:"show_in_quick_edit,omitempty"`
+	ShowUI            bool `json:"show_ui,omitempty"`
+}
 type Taxonomy struct {
-	Description  string                 `json:"description,omitempty"`
-	Hierarchical bool                   `json:"hierarchical,omitempty"`
-	Labels       map[string]interface{} `json:"labels,omitempty"`
-	Name         string                 `json:"name,omitempty"`
-	Slug         string                 `json:"slug,omitempty"`
-	ShowCloud    bool                   `json:"show_cloud,omitempty"`
-	Types        []string               `json:"types,omitempty"`
+	Capabilities  map[string]string      `json:"capabilities,omitempty"`
+	Description   string                 `json:"description,omitempty"`
+	Hierarchical  bool                   `json:"hierarchical,omitempty"`
+	Labels        map[string]interface{} `json:"labels,omitempty"`
+	Name          string                 `json:"name,omitempty"`
+	Slug          string                 `json:"slug,omitempty"`
+	ShowCloud     bool                   `json:"show_cloud,omitempty"`
+	Types         []string               `json:"types,omitempty"`
+	RestBase      string                 `json:"rest_base,omitempty"`
+	RestNamespace string                 `json:"rest_namespace,omitempty"`
+	Visibility    TaxonomyVisibility     `json:"visibility,omitempty"`
 }
 type TaxonomiesCollection struct {
 	client *Client
@@ -20,14 +33,20 @@ type TaxonomiesCollection struct {
 }
 
 func (col *TaxonomiesCollection) List(params interface{}) (map[string]Taxonomy, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *TaxonomiesCollection) ListContext(ctx context.Context, params interface{}) (map[string]Taxonomy, *http.Response, []byte, error) {
 	var taxonomies map[string]Taxonomy
-	resp, body, err := col.client.List(col.url, params, &taxonomies)
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &taxonomies)
 	return taxonomies, resp, body, err
 }
 
 func (col *TaxonomiesCollection) Get(slug string, params interface{}) (*Taxonomy, *http.Response, []byte, error) {
+	return col.GetContext(context.Background(), slug, params)
+}
+func (col *TaxonomiesCollection) GetContext(ctx context.Context, slug string, params interface{}) (*Taxonomy, *http.Response, []byte, error) {
 	var taxonomy Taxonomy
 	entityURL := fmt.Sprintf("%v/%v", col.url, slug)
-	resp, body, err := col.client.Get(entityURL, params, &taxonomy)
+	resp, body, err := col.client.GetContext(ctx, entityURL, params, &taxonomy)
 	return &taxonomy, resp, body, err
 }
@@ -0,0 +1,175 @@
+package wordpress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// TaxonomyTermsCollection is the terms collection of any taxonomy, at the
+// route given by the taxonomy's rest_base, e.g. `/genre` for a custom
+// `genre` taxonomy. CategoriesCollection and TagsCollection are built on it.
+type TaxonomyTermsCollection struct {
+	*Collection[Term]
+
+	// Taxonomy is the taxonomy as returned by `/taxonomies/[slug]`. It is nil
+	// for the categories and tags collections, whose routes are fixed.
+	Taxonomy *Taxonomy
+}
+
+func newTaxonomyTermsCollection(client *Client, url string, taxonomy *Taxonomy) *TaxonomyTermsCollection {
+	return &TaxonomyTermsCollection{
+		Collection: newCollection[Term](client, url, nil),
+		Taxonomy:   taxonomy,
+	}
+}
+
+// Taxonomy returns the terms collection of the taxonomy slug, resolving its
+// route from `/taxonomies`.
+func (client *Client) Taxonomy(slug string) (*TaxonomyTermsCollection, error) {
+	return client.TaxonomyContext(context.Background(), slug)
+}
+func (client *Client) TaxonomyContext(ctx context.Context, slug string) (*TaxonomyTermsCollection, error) {
+	taxonomy, _, _, err := client.Taxonomies().GetContext(ctx, slug, nil)
+	if err != nil {
+		return nil, fmt.Errorf("wordpress: resolving taxonomy %v: %w", slug, err)
+	}
+	if taxonomy.RestBase == "" {
+		return nil, fmt.Errorf("wordpress: taxonomy %v is not available in the REST API", slug)
+	}
+	return newTaxonomyTermsCollection(client, client.namespaceURL(taxonomy.RestNamespace, taxonomy.RestBase), taxonomy), nil
+}
+
+// Children lists the direct children of the term parent in a hierarchical
+// taxonomy. Use 0 for top-level terms.
+func (col *TaxonomyTermsCollection) Children(parent int, params *TermListParams) ([]Term, *http.Response, []byte, error) {
+	return col.ChildrenContext(context.Background(), parent, params)
+}
+func (col *TaxonomyTermsCollection) ChildrenContext(ctx context.Context, parent int, params *TermListParams) ([]Term, *http.Response, []byte, error) {
+	return col.ListContext(ctx, withParent(parent, params))
+}
+
+// AllChildren returns an iterator over all direct children of the term
+// parent, fetching further pages as needed.
+func (col *TaxonomyTermsCollection) AllChildren(ctx context.Context, parent int, params *TermListParams) iter.Seq2[Term, error] {
+	return col.All(ctx, withParent(parent, params))
+}
+
+// Delete deletes the term id. Terms cannot be trashed, so the request is
+// always sent with `force=true`, as with ForceDelete.
+func (col *TaxonomyTermsCollection) Delete(id int) (*Term, *http.Response, []byte, error) {
+	return col.ForceDeleteContext(context.Background(), id)
+}
+func (col *TaxonomyTermsCollection) DeleteContext(ctx context.Context, id int) (*Term, *http.Response, []byte, error) {
+	return col.ForceDeleteContext(ctx, id)
+}
+
+func withParent(parent int, params *TermListParams) *TermListParams {
+	p := TermListParams{}
+	if params != nil {
+		p = *params
+	}
+	p.Parent = &parent
+	return &p
+}
+
+// TaxonomyTerms holds the term IDs assigned to a post for taxonomies that are
+// not modelled as struct fields, keyed by the taxonomy's rest_base, e.g.
+// {"genre": {3, 4}}.
+type TaxonomyTerms map[string][]int
+
+// decodeTaxonomyTerms collects the term IDs of the taxonomies linked from the
+// `wp:term` relation of raw, except for those that are JSON fields of known.
+// Other list fields, e.g. of plugins, are left alone.
+func decodeTaxonomyTerms(raw []byte, known map[string]bool) TaxonomyTerms {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil
+	}
+	var links Links
+	if err := json.Unmarshal(fields["_links"], &links); err != nil {
+		return nil
+	}
+	var terms TaxonomyTerms
+	for _, link := range links["wp:term"] {
+		restBase := linkRestBase(link.Href)
+		value, ok := fields[restBase]
+		if restBase == "" || known[restBase] || !ok {
+			continue
+		}
+		var ids []int
+		if err := json.Unmarshal(value, &ids); err != nil {
+			continue
+		}
+		if terms == nil {
+			terms = TaxonomyTerms{}
+		}
+		terms[restBase] = ids
+	}
+	return terms
+}
+
+// linkRestBase returns the rest_base of a `wp:term` link such as
+// `https://example.com/wp-json/wp/v2/genres?post=1`.
+func linkRestBase(href string) string {
+	u, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	if route := u.Query().Get("rest_route"); route != "" {
+		// sites without pretty permalinks
+		return path.Base(route)
+	}
+	return path.Base(strings.TrimSuffix(u.Path, "/"))
+}
+
+// fields returns terms as extra request fields.
+func (terms TaxonomyTerms) fields() map[string]interface{} {
+	if len(terms) == 0 {
+		return nil
+	}
+	fields := make(map[string]interface{}, len(terms))
+	for restBase, ids := range terms {
+		if ids == nil {
+			ids = []int{}
+		}
+		fields[restBase] = ids
+	}
+	return fields
+}
+
+var jsonFieldsCache sync.Map
+
+// jsonFields returns the JSON names of the fields of the struct type t.
+func jsonFields(t reflect.Type) map[string]bool {
+	if cached, ok := jsonFieldsCache.Load(t); ok {
+		return cached.(map[string]bool)
+	}
+	fields := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			for embedded := range jsonFields(field.Type) {
+				fields[embedded] = true
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = true
+	}
+	jsonFieldsCache.Store(t, fields)
+	return fields
+}
@@ -0,0 +1,139 @@
+package wordpress_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestTaxonomy_CustomTaxonomyTerms(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/taxonomies/genre":
+			w.Write([]byte(`{"name":"Genres","slug":"genre","types":["book"],"hierarchical":true,"rest_base":"genres","rest_namespace":"wp/v2","visibility":{"public":true,"show_ui":true}}`))
+		case "/genres":
+			if r.URL.Query().Get("parent") != "0" {
+				t.Errorf("Unexpected query: %v", r.URL.RawQuery)
+			}
+			w.Write([]byte(`[{"id":3,"count":5,"name":"Fiction","slug":"fiction","taxonomy":"genre"}]`))
+		case "/genres/3":
+			if r.URL.Query().Get("force") != "true" {
+				t.Errorf("Terms should be deleted with force=true")
+			}
+			w.Write([]byte(`{"deleted":true,"previous":{"id":3,"name":"Fiction","taxonomy":"genre"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	genres, err := wp.Taxonomy("genre")
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if !genres.Taxonomy.Hierarchical || !genres.Taxonomy.Visibility.Public {
+		t.Errorf("Unexpected taxonomy: %+v", genres.Taxonomy)
+	}
+
+	top, _, _, err := genres.Children(0, nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if len(top) != 1 || top[0].Taxonomy != "genre" || top[0].Count != 5 {
+		t.Errorf("Unexpected terms: %+v", top)
+	}
+
+	deleted, _, _, err := genres.Delete(3)
+	if err != nil || deleted.ID != 3 {
+		t.Errorf("Unexpected deleted term: %+v, %v", deleted, err)
+	}
+}
+
+func TestTaxonomy_Unknown(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":"rest_taxonomy_invalid","message":"Invalid taxonomy.","data":{"status":404}}`))
+	})
+
+	_, err := wp.Taxonomy("missing")
+	if !wordpress.HasErrorCode(err, "rest_taxonomy_invalid") {
+		t.Errorf("Expected rest_taxonomy_invalid error, got %v", err)
+	}
+}
+
+func TestPostsTaxonomyTerms(t *testing.T) {
+	var sent map[string]interface{}
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		links := `"_links":{"wp:term":[` +
+			`{"taxonomy":"category","href":"https://example.com/wp-json/wp/v2/categories?post=1"},` +
+			`{"taxonomy":"genre","href":"https://example.com/wp-json/wp/v2/genres?post=1"},` +
+			`{"taxonomy":"product_cat","href":"https://example.com/wp-json/wc/v3/product_cat?post=1"}]}`
+		if r.Method == "POST" {
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.Write([]byte(`{"id":1,"categories":[1],"tags":[],"genres":[3,4],"product_cat":[],"acf":[],` + links + `}`))
+			return
+		}
+		w.Write([]byte(`[{"id":1,"categories":[1],"tags":[],"genres":[3],"product_cat":[],"acf":[],"class_list":["post-1"],` + links + `}]`))
+	})
+
+	posts, _, _, err := wp.Posts().List(nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if fmt.Sprint(posts[0].TaxonomyTerms) != "map[genres:[3] product_cat:[]]" {
+		t.Errorf("Unexpected taxonomy terms: %v", posts[0].TaxonomyTerms)
+	}
+
+	post := posts[0]
+	post.TaxonomyTerms["genres"] = []int{3, 4}
+	updated, _, _, err := wp.Posts().Update(post.ID, &post)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if fmt.Sprint(sent["genres"]) != "[3 4]" || fmt.Sprint(sent["product_cat"]) != "[]" {
+		t.Errorf("Taxonomy terms should be sent with the post: %v", sent)
+	}
+	if fmt.Sprint(sent["categories"]) != "[1]" {
+		t.Errorf("Declared fields should still be sent: %v", sent)
+	}
+	if _, ok := sent["acf"]; ok {
+		t.Errorf("Fields of unlinked taxonomies should not be sent: %v", sent)
+	}
+	if fmt.Sprint(updated.TaxonomyTerms) != "map[genres:[3 4] product_cat:[]]" {
+		t.Errorf("Unexpected taxonomy terms: %v", updated.TaxonomyTerms)
+	}
+}
+
+func TestPostTypeOf_TaxonomyTerms(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/types/event":
+			w.Write([]byte(`{"slug":"event","rest_base":"events","taxonomies":["genre"]}`))
+		default:
+			w.Write([]byte(`[{"id":1,"venue":"Main hall","genres":[3],"seats":[1,2],"_links":{"wp:term":[{"taxonomy":"genre","href":"https://example.com/?rest_route=/wp/v2/genres&post=1"}]}}]`))
+		}
+	})
+
+	type event struct {
+		wordpress.Post
+		Venue string `json:"venue,omitempty"`
+		Seats []int  `json:"seats,omitempty"`
+	}
+	events, err := wordpress.PostTypeOf[event](context.Background(), wp, "event")
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	list, _, _, err := events.List(nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if list[0].Venue != "Main hall" || fmt.Sprint(list[0].Seats) != "[1 2]" {
+		t.Errorf("Fields of the custom struct should be decoded: %+v", list[0])
+	}
+	if fmt.Sprint(list[0].TaxonomyTerms) != "map[genres:[3]]" {
+		t.Errorf("Unexpected taxonomy terms: %v", list[0].TaxonomyTerms)
+	}
+}
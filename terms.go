@@ -1,19 +1,34 @@
 package wordpress
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
 
 type Term struct {
-	ID          int    `json:"id,omitempty"`
-	Count       int    `json:"integer,omitempty"`
-	Description string `json:"description,omitempty"`
-	Link        string `json:"link,omitempty"`
-	Name        string `json:"name"`
-	Slug        string `json:"slug,omitempty"`
-	Taxonomy    string `json:"taxonomy,omitempty"`
-	Parent      int    `json:"parent,omitempty"`
+	ID          int        `json:"id,omitempty"`
+	Count       int        `json:"count,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Link        string     `json:"link,omitempty"`
+	Name        string     `json:"name"`
+	Slug        string     `json:"slug,omitempty"`
+	Taxonomy    string     `json:"taxonomy,omitempty"`
+	Parent      int        `json:"parent,omitempty"`
+	Meta        MetaFields `json:"meta,omitempty"`
+	Links       Links      `json:"_links,omitempty"`
+}
+
+// TermListParams are the query parameters of term List calls.
+type TermListParams struct {
+	ListParams
+
+	// HideEmpty and Parent are pointers so that false and 0 (top-level
+	// terms) can be sent.
+	HideEmpty *bool    `url:"hide_empty,omitempty"`
+	Parent    *int     `url:"parent,omitempty"`
+	Post      int      `url:"post,omitempty"`
+	Slug      []string `url:"slug,omitempty"`
 }
 type TermsCollection struct {
 	client *Client
@@ -21,57 +36,34 @@ type TermsCollection struct {
 }
 
 func (col *TermsCollection) List(taxonomy string, params interface{}) ([]Term, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), taxonomy, params)
+}
+func (col *TermsCollection) ListContext(ctx context.Context, taxonomy string, params interface{}) ([]Term, *http.Response, []byte, error) {
 	var terms []Term
 	url := fmt.Sprintf("%v/%v", col.url, taxonomy)
-	resp, body, err := col.client.List(url, params, &terms)
+	resp, body, err := col.client.ListContext(ctx, url, params, &terms)
 	return terms, resp, body, err
 }
+
+// Deprecated: the `/terms/tag` route was removed in Wordpress 4.7. Use
+// Client.Tags instead.
 func (col *TermsCollection) Tag() *TermsTaxonomyCollection {
 	return &TermsTaxonomyCollection{
-		client:       col.client,
-		url:          fmt.Sprintf("%v/tag", col.url),
+		Collection:   newCollection[Term](col.client, fmt.Sprintf("%v/tag", col.url), nil),
 		taxonomyBase: "tag",
 	}
 }
+
+// Deprecated: the `/terms/category` route was removed in Wordpress 4.7. Use
+// Client.Categories instead.
 func (col *TermsCollection) Category() *TermsTaxonomyCollection {
 	return &TermsTaxonomyCollection{
-		client:       col.client,
-		url:          fmt.Sprintf("%v/category", col.url),
+		Collection:   newCollection[Term](col.client, fmt.Sprintf("%v/category", col.url), nil),
 		taxonomyBase: "category",
 	}
 }
 
 type TermsTaxonomyCollection struct {
-	client       *Client
-	url          string
+	*Collection[Term]
 	taxonomyBase string
 }
-
-func (col *TermsTaxonomyCollection) List(params interface{}) ([]Term, *http.Response, []byte, error) {
-	var terms []Term
-	resp, body, err := col.client.List(col.url, params, &terms)
-	return terms, resp, body, err
-}
-func (col *TermsTaxonomyCollection) Create(new *Term) (*Term, *http.Response, []byte, error) {
-	var created Term
-	resp, body, err := col.client.Create(col.url, new, &created)
-	return &created, resp, body, err
-}
-func (col *TermsTaxonomyCollection) Get(id int, params interface{}) (*Term, *http.Response, []byte, error) {
-	var entity Term
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Get(entityURL, params, &entity)
-	return &entity, resp, body, err
-}
-func (col *TermsTaxonomyCollection) Update(id int, post *Term) (*Term, *http.Response, []byte, error) {
-	var updated Term
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Update(entityURL, post, &updated)
-	return &updated, resp, body, err
-}
-func (col *TermsTaxonomyCollection) Delete(id int, params interface{}) (*Term, *http.Response, []byte, error) {
-	var deleted Term
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Delete(entityURL, params, &deleted)
-	return &deleted, resp, body, err
-}
@@ -0,0 +1,233 @@
+package wordpress
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+const (
+	ThemeStatusActive   = "active"
+	ThemeStatusInactive = "inactive"
+)
+
+// ThemeTags are the tags of a theme, as a list and rendered as text.
+type ThemeTags struct {
+	Raw      []string `json:"raw,omitempty"`
+	Rendered string   `json:"rendered,omitempty"`
+}
+
+type Theme struct {
+	// Stylesheet identifies the theme by its directory, e.g. "twentytwentyfour".
+	Stylesheet string `json:"stylesheet,omitempty"`
+	// Template is the directory of the parent theme, or Stylesheet.
+	Template      string        `json:"template,omitempty"`
+	Name          RenderedText  `json:"name,omitempty"`
+	Description   RenderedText  `json:"description,omitempty"`
+	Author        RenderedText  `json:"author,omitempty"`
+	AuthorURI     RenderedText  `json:"author_uri,omitempty"`
+	ThemeURI      RenderedText  `json:"theme_uri,omitempty"`
+	Version       string        `json:"version,omitempty"`
+	RequiresWP    string        `json:"requires_wp,omitempty"`
+	RequiresPHP   string        `json:"requires_php,omitempty"`
+	TextDomain    string        `json:"textdomain,omitempty"`
+	Screenshot    string        `json:"screenshot,omitempty"`
+	Tags          ThemeTags     `json:"tags,omitempty"`
+	Status        string        `json:"status,omitempty"`
+	IsBlockTheme  bool          `json:"is_block_theme,omitempty"`
+	StylesheetURI string        `json:"stylesheet_uri,omitempty"`
+	TemplateURI   string        `json:"template_uri,omitempty"`
+	ThemeSupports ThemeSupports `json:"theme_supports,omitempty"`
+	Links         Links         `json:"_links,omitempty"`
+}
+
+// Supports reports whether the theme supports feature, a theme_supports key
+// such as "post-thumbnails", "block-templates" or "custom-logo", like
+// current_theme_supports does. For features taking a list, such as
+// "post-thumbnails" (post types), "html5" (markup) or "post-formats", args are
+// required in the list:
+//
+//	theme.Supports("post-thumbnails", "page")
+//	theme.Supports("post-formats", wordpress.PostFormatVideo)
+//
+// theme_supports is only returned for the active theme.
+func (entity *Theme) Supports(feature string, args ...string) bool {
+	supports := &entity.ThemeSupports
+	switch feature {
+	case "post-formats":
+		for _, arg := range args {
+			if !slices.Contains(supports.Formats, arg) {
+				return false
+			}
+		}
+		return slices.ContainsFunc(supports.Formats, func(format string) bool { return format != PostFormatStandard })
+	case "block-templates":
+		if entity.IsBlockTheme {
+			return true
+		}
+	}
+
+	value, ok := supports.Features[feature]
+	if !ok || !value.Enabled {
+		return false
+	}
+	var list []string
+	if len(args) == 0 || json.Unmarshal(value.Args, &list) != nil {
+		return true
+	}
+	for _, arg := range args {
+		if !slices.Contains(list, arg) {
+			return false
+		}
+	}
+	return true
+}
+
+// ThemeFeature is a theme_supports value: false if the feature is not
+// supported, else true or the arguments the theme registered it with.
+type ThemeFeature struct {
+	Enabled bool
+	// Args holds the JSON arguments of the feature, if any.
+	Args json.RawMessage
+}
+
+func (feature *ThemeFeature) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch string(data) {
+	case "false", "null", "[]", "":
+		*feature = ThemeFeature{}
+	case "true":
+		*feature = ThemeFeature{Enabled: true}
+	default:
+		*feature = ThemeFeature{Enabled: true, Args: append(json.RawMessage(nil), data...)}
+	}
+	return nil
+}
+func (feature ThemeFeature) MarshalJSON() ([]byte, error) {
+	if feature.Enabled && len(feature.Args) > 0 {
+		return feature.Args, nil
+	}
+	return json.Marshal(feature.Enabled)
+}
+
+// Decode decodes the arguments of the feature into v, e.g. a ThemeCustomLogo
+// for "custom-logo".
+func (feature ThemeFeature) Decode(v interface{}) error {
+	if len(feature.Args) == 0 {
+		return fmt.Errorf("wordpress: theme feature has no arguments")
+	}
+	return json.Unmarshal(feature.Args, v)
+}
+
+// ThemeCustomLogo are the arguments of the "custom-logo" feature.
+type ThemeCustomLogo struct {
+	Width              int      `json:"width,omitempty"`
+	Height             int      `json:"height,omitempty"`
+	FlexWidth          bool     `json:"flex-width,omitempty"`
+	FlexHeight         bool     `json:"flex-height,omitempty"`
+	HeaderText         []string `json:"header-text,omitempty"`
+	UnlinkHomepageLogo bool     `json:"unlink-homepage-logo,omitempty"`
+}
+
+type ThemeColor struct {
+	Name  string `json:"name,omitempty"`
+	Slug  string `json:"slug,omitempty"`
+	Color string `json:"color,omitempty"`
+}
+
+type ThemeFontSize struct {
+	Name string  `json:"name,omitempty"`
+	Slug string  `json:"slug,omitempty"`
+	Size float64 `json:"size,omitempty"`
+}
+
+// ThemeSupports are the features supported by the active theme. The
+// commonly used ones are typed; Features holds every feature by name,
+// including those registered by plugins.
+type ThemeSupports struct {
+	AlignWide          bool            `json:"align-wide,omitempty"`
+	AutomaticFeedLinks bool            `json:"automatic-feed-links,omitempty"`
+	BlockTemplates     bool            `json:"block-templates,omitempty"`
+	BlockTemplateParts bool            `json:"block-template-parts,omitempty"`
+	CustomBackground   ThemeFeature    `json:"custom-background,omitempty"`
+	CustomHeader       ThemeFeature    `json:"custom-header,omitempty"`
+	CustomLogo         ThemeFeature    `json:"custom-logo,omitempty"`
+	EditorColorPalette []ThemeColor    `json:"-"`
+	EditorFontSizes    []ThemeFontSize `json:"-"`
+	EditorStyles       bool            `json:"editor-styles,omitempty"`
+	// Formats are the supported post formats, including "standard".
+	Formats          []string     `json:"formats,omitempty"`
+	HTML5            ThemeFeature `json:"html5,omitempty"`
+	PostThumbnails   ThemeFeature `json:"post-thumbnails,omitempty"`
+	ResponsiveEmbeds bool         `json:"responsive-embeds,omitempty"`
+	TitleTag         bool         `json:"title-tag,omitempty"`
+	WPBlockStyles    bool         `json:"wp-block-styles,omitempty"`
+
+	Features map[string]ThemeFeature `json:"-"`
+}
+
+func (supports *ThemeSupports) UnmarshalJSON(data []byte) error {
+	type fields ThemeSupports
+	if err := json.Unmarshal(data, (*fields)(supports)); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &supports.Features); err != nil {
+		return err
+	}
+	// palettes are false when the theme does not define one
+	supports.EditorColorPalette = nil
+	supports.Features["editor-color-palette"].Decode(&supports.EditorColorPalette)
+	supports.EditorFontSizes = nil
+	supports.Features["editor-font-sizes"].Decode(&supports.EditorFontSizes)
+	return nil
+}
+
+// ThemeListParams are the query parameters of ThemesCollection.List.
+type ThemeListParams struct {
+	Context string   `url:"context,omitempty"`
+	Status  []string `url:"status,omitempty"`
+}
+
+// ThemesCollection is the `/themes` collection.
+type ThemesCollection struct {
+	client *Client
+	url    string
+}
+
+func (col *ThemesCollection) List(params interface{}) ([]Theme, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *ThemesCollection) ListContext(ctx context.Context, params interface{}) ([]Theme, *http.Response, []byte, error) {
+	var themes []Theme
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &themes)
+	return themes, resp, body, err
+}
+
+// Get returns the theme stylesheet.
+func (col *ThemesCollection) Get(stylesheet string, params interface{}) (*Theme, *http.Response, []byte, error) {
+	return col.GetContext(context.Background(), stylesheet, params)
+}
+func (col *ThemesCollection) GetContext(ctx context.Context, stylesheet string, params interface{}) (*Theme, *http.Response, []byte, error) {
+	var theme Theme
+	entityURL := fmt.Sprintf("%v/%v", col.url, escapePath(stylesheet))
+	resp, body, err := col.client.GetContext(ctx, entityURL, params, &theme)
+	return &theme, resp, body, err
+}
+
+// Active returns the active theme.
+func (col *ThemesCollection) Active() (*Theme, *http.Response, []byte, error) {
+	return col.ActiveContext(context.Background())
+}
+func (col *ThemesCollection) ActiveContext(ctx context.Context) (*Theme, *http.Response, []byte, error) {
+	themes, resp, body, err := col.ListContext(ctx, &ThemeListParams{Status: []string{ThemeStatusActive}})
+	if err != nil {
+		return nil, resp, body, err
+	}
+	if len(themes) == 0 {
+		return nil, resp, body, fmt.Errorf("wordpress: no active theme returned")
+	}
+	return &themes[0], resp, body, nil
+}
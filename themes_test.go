@@ -0,0 +1,88 @@
+package wordpress_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+func TestFixture_Theme(t *testing.T) {
+	wp, fixture := fixtureServerClient(t, "theme.json")
+
+	theme, _, _, err := wp.Themes().Get("twentytwentyfour-child", nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	assertFixtureFields(t, fixture, theme, "_links", "theme_supports")
+
+	supports := theme.ThemeSupports
+	if !supports.BlockTemplates || supports.AlignWide || !supports.EditorStyles || fmt.Sprint(supports.Formats) != "[standard video gallery]" {
+		t.Errorf("Unexpected typed features: %+v", supports)
+	}
+	if len(supports.EditorColorPalette) != 2 || supports.EditorColorPalette[1].Color != "#111111" || supports.EditorFontSizes != nil {
+		t.Errorf("Unexpected palettes: %+v, %+v", supports.EditorColorPalette, supports.EditorFontSizes)
+	}
+	var logo wordpress.ThemeCustomLogo
+	if err := supports.CustomLogo.Decode(&logo); err != nil || logo.Width != 240 || !logo.FlexWidth {
+		t.Errorf("Unexpected custom logo: %+v, %v", logo, err)
+	}
+	if supports.CustomHeader.Enabled || !supports.Features["my-plugin-feature"].Enabled {
+		t.Errorf("Unexpected features: %+v", supports.Features)
+	}
+}
+
+func TestTheme_Supports(t *testing.T) {
+	wp, _ := fixtureServerClient(t, "theme.json")
+	theme, _, _, err := wp.Themes().Get("twentytwentyfour-child", nil)
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+
+	for _, c := range []struct {
+		feature  string
+		args     []string
+		expected bool
+	}{
+		{"block-templates", nil, true},
+		{"post-thumbnails", nil, true},
+		{"post-thumbnails", []string{"page"}, true},
+		{"post-thumbnails", []string{"product"}, false},
+		{"html5", []string{"gallery", "caption"}, true},
+		{"post-formats", nil, true},
+		{"post-formats", []string{wordpress.PostFormatVideo}, true},
+		{"post-formats", []string{wordpress.PostFormatAside}, false},
+		{"custom-logo", nil, true},
+		{"custom-header", nil, false},
+		{"title-tag", nil, false},
+		{"border", nil, true},
+		{"unknown", nil, false},
+	} {
+		if got := theme.Supports(c.feature, c.args...); got != c.expected {
+			t.Errorf("Supports(%v, %v): expected %v, got %v", c.feature, c.args, c.expected, got)
+		}
+	}
+
+	blockTheme := wordpress.Theme{IsBlockTheme: true}
+	if !blockTheme.Supports("block-templates") {
+		t.Errorf("Block themes support block templates")
+	}
+}
+
+func TestThemesActive(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("status") != "active" {
+			t.Errorf("Unexpected query: %v", r.URL.RawQuery)
+		}
+		w.Write([]byte(`[{"stylesheet":"twentytwentyfour","status":"active","theme_supports":{"post-thumbnails":true}}]`))
+	})
+
+	theme, _, _, err := wp.Themes().Active()
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if theme.Stylesheet != "twentytwentyfour" || !theme.Supports("post-thumbnails", "post") {
+		t.Errorf("Unexpected theme: %+v", theme)
+	}
+}
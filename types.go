@@ -1,6 +1,7 @@
 package wordpress
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -22,11 +23,37 @@ type TypeLabels struct {
 	NameAdminBar    string `json:"name_admin_bar,omitempty"`
 }
 type Type struct {
-	Description  string     `json:"description,omitempty"`
-	Hierarchical bool       `json:"hierarchical,omitempty"`
-	Name         string     `json:"name,omitempty"`
-	Slug         string     `json:"slug,omitempty"`
-	Labels       TypeLabels `json:"labels,omitempty"`
+	Description   string            `json:"description,omitempty"`
+	Hierarchical  bool              `json:"hierarchical,omitempty"`
+	Viewable      bool              `json:"viewable,omitempty"`
+	Name          string            `json:"name,omitempty"`
+	Slug          string            `json:"slug,omitempty"`
+	Labels        TypeLabels        `json:"labels,omitempty"`
+	Capabilities  map[string]string `json:"capabilities,omitempty"`
+	Icon          string            `json:"icon,omitempty"`
+	HasArchive    interface{}       `json:"has_archive,omitempty"`
+	Taxonomies    []string          `json:"taxonomies,omitempty"`
+	RestBase      string            `json:"rest_base,omitempty"`
+	RestNamespace string            `json:"rest_namespace,omitempty"`
+	// Supports is only returned with `context=edit`.
+	Supports map[string]interface{} `json:"supports,omitempty"`
+}
+
+// HasSupport reports whether the type supports feature, e.g. "revisions" or
+// "custom-fields". It returns true if the supported features are unknown
+// because the type was not fetched with `context=edit`.
+func (entity *Type) HasSupport(feature string) bool {
+	if entity.Supports == nil {
+		return true
+	}
+	supported, ok := entity.Supports[feature]
+	if !ok {
+		return false
+	}
+	if b, isBool := supported.(bool); isBool {
+		return b
+	}
+	return supported != nil
 }
 
 type Types struct {
@@ -41,14 +68,30 @@ type TypesCollection struct {
 }
 
 func (col *TypesCollection) List(params interface{}) (*Types, *http.Response, []byte, error) {
+	return col.ListContext(context.Background(), params)
+}
+func (col *TypesCollection) ListContext(ctx context.Context, params interface{}) (*Types, *http.Response, []byte, error) {
 	var types Types
-	resp, body, err := col.client.List(col.url, params, &types)
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &types)
 	return &types, resp, body, err
 }
 
+// ListMap returns all post types keyed by slug, including custom post types.
+func (col *TypesCollection) ListMap(params interface{}) (map[string]Type, *http.Response, []byte, error) {
+	return col.ListMapContext(context.Background(), params)
+}
+func (col *TypesCollection) ListMapContext(ctx context.Context, params interface{}) (map[string]Type, *http.Response, []byte, error) {
+	var types map[string]Type
+	resp, body, err := col.client.ListContext(ctx, col.url, params, &types)
+	return types, resp, body, err
+}
+
 func (col *TypesCollection) Get(slug string, params interface{}) (*Type, *http.Response, []byte, error) {
+	return col.GetContext(context.Background(), slug, params)
+}
+func (col *TypesCollection) GetContext(ctx context.Context, slug string, params interface{}) (*Type, *http.Response, []byte, error) {
 	var entity Type
 	entityURL := fmt.Sprintf("%v/%v", col.url, slug)
-	resp, body, err := col.client.Get(entityURL, params, &entity)
+	resp, body, err := col.client.GetContext(ctx, entityURL, params, &entity)
 	return &entity, resp, body, err
 }
@@ -0,0 +1,208 @@
+package wordpress
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// MediaUploadOptions describe a file uploaded with MediaCollection.Create.
+//
+// The file is read from Reader, or from Data if Reader is nil. Readers are
+// streamed without being buffered in memory; their length is taken from Size,
+// or from Stat() for files, and they are sent chunked if it is unknown.
+// Streamed uploads are never retried, as the body cannot be replayed.
+//
+// By default the file is sent as the raw request body. When any of the
+// attachment fields is set, or Multipart is true, the upload is sent as
+// multipart/form-data with the fields in the same request.
+type MediaUploadOptions struct {
+	Filename string
+	// ContentType of the file. If empty, it is guessed from the Filename
+	// extension, then from the first bytes of the file.
+	ContentType string
+	Data        []byte
+
+	Reader io.Reader
+	// Size is the length of Reader in bytes, 0 if unknown.
+	Size int64
+
+	Multipart   bool
+	Title       string
+	AltText     string
+	Caption     string
+	Description string
+	// Post is the ID of the post to attach the media to.
+	Post int
+
+	// Progress, if set, is called as the file is sent with the number of
+	// file bytes sent so far and the file size, -1 if unknown. It is called
+	// from the goroutine writing the request.
+	Progress func(sent int64, total int64)
+}
+
+func (options *MediaUploadOptions) multipart() bool {
+	return options.Multipart || options.Title != "" || options.AltText != "" ||
+		options.Caption != "" || options.Description != "" || options.Post != 0
+}
+
+// file returns the file content, its size (-1 if unknown) and content type.
+func (options *MediaUploadOptions) file() (io.Reader, int64, string, error) {
+	var content io.Reader
+	size := int64(-1)
+	if options.Reader != nil {
+		content = options.Reader
+		if options.Size > 0 {
+			size = options.Size
+		} else if file, ok := options.Reader.(interface{ Stat() (os.FileInfo, error) }); ok {
+			if info, err := file.Stat(); err == nil && info.Mode().IsRegular() {
+				size = info.Size()
+			}
+		}
+	} else {
+		content = bytes.NewReader(options.Data)
+		size = int64(len(options.Data))
+	}
+
+	contentType := options.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(options.Filename))
+	}
+	if contentType == "" {
+		// sniff without consuming the head of the file
+		head := make([]byte, 512)
+		n, err := io.ReadFull(content, head)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, 0, "", fmt.Errorf("error reading media: %w", err)
+		}
+		contentType = http.DetectContentType(head[:n])
+		content = io.MultiReader(bytes.NewReader(head[:n]), content)
+	}
+	return options.withProgress(content, size), size, contentType, nil
+}
+
+func (options *MediaUploadOptions) withProgress(content io.Reader, size int64) io.Reader {
+	if options.Progress == nil {
+		return content
+	}
+	return &progressReader{reader: content, total: size, progress: options.Progress}
+}
+
+// request builds the upload request to url.
+func (options *MediaUploadOptions) request(ctx context.Context, url string) (*http.Request, error) {
+	content, size, contentType, err := options.file()
+	if err != nil {
+		return nil, err
+	}
+
+	wrap := func(content io.Reader) io.Reader { return content }
+	length := size
+	if options.multipart() {
+		var head bytes.Buffer
+		writer := multipart.NewWriter(&head)
+		fields := [][2]string{
+			{"title", options.Title},
+			{"alt_text", options.AltText},
+			{"caption", options.Caption},
+			{"description", options.Description},
+		}
+		if options.Post != 0 {
+			fields = append(fields, [2]string{"post", strconv.Itoa(options.Post)})
+		}
+		for _, field := range fields {
+			if field[1] == "" {
+				continue
+			}
+			if err := writer.WriteField(field[0], field[1]); err != nil {
+				return nil, fmt.Errorf("error writing media fields: %w", err)
+			}
+		}
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", `form-data; name="file"; filename=`+quoteFilename(options.Filename))
+		header.Set("Content-Type", contentType)
+		if _, err := writer.CreatePart(header); err != nil {
+			return nil, fmt.Errorf("error writing media fields: %w", err)
+		}
+		prefix := bytes.Clone(head.Bytes())
+		head.Reset()
+		writer.Close()
+		trailer := head.Bytes()
+
+		wrap = func(content io.Reader) io.Reader {
+			return io.MultiReader(bytes.NewReader(prefix), content, bytes.NewReader(trailer))
+		}
+		if size >= 0 {
+			length = int64(len(prefix)) + size + int64(len(trailer))
+		}
+		contentType = writer.FormDataContentType()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, wrap(content))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = length
+	if length == 0 {
+		req.Body = http.NoBody
+	}
+	if options.Reader == nil && length >= 0 {
+		// Data is in memory and can be sent again on retries
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(wrap(options.withProgress(bytes.NewReader(options.Data), size))), nil
+		}
+	}
+	req.Header.Set("Content-Type", contentType)
+	if !options.multipart() && options.Filename != "" {
+		req.Header.Set("Content-Disposition", contentDisposition(options.Filename))
+	}
+	req.Header.Set("Accept", "application/json")
+	return req, nil
+}
+
+// contentDisposition returns the Content-Disposition header of a raw upload.
+// WordPress only reads the quoted `filename` parameter, so it is always sent,
+// followed by an RFC 5987 `filename*` for non-ASCII names.
+func contentDisposition(filename string) string {
+	disposition := "attachment; filename=" + quoteFilename(filename)
+	for _, r := range filename {
+		if r >= utf8.RuneSelf {
+			escaped := strings.ReplaceAll(url.QueryEscape(filename), "+", "%20")
+			return disposition + "; filename*=UTF-8''" + escaped
+		}
+	}
+	return disposition
+}
+
+var filenameQuoter = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r", "", "\n", "")
+
+func quoteFilename(filename string) string {
+	return `"` + filenameQuoter.Replace(filename) + `"`
+}
+
+// progressReader reports the bytes read from reader.
+type progressReader struct {
+	reader   io.Reader
+	sent     int64
+	total    int64
+	progress func(sent int64, total int64)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.sent += int64(n)
+		r.progress(r.sent, r.total)
+	}
+	return n, err
+}
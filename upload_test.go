@@ -0,0 +1,211 @@
+package wordpress_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/eideroliveira/wordpress"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// onlyReader hides the length of a reader.
+type onlyReader struct {
+	io.Reader
+}
+
+func TestMediaCreate_RawData(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "image/jpeg" {
+			t.Errorf("Content type should be guessed from the extension: %v", r.Header.Get("Content-Type"))
+		}
+		if r.Header.Get("Content-Disposition") != `attachment; filename="my photo.jpg"` {
+			t.Errorf("Unexpected Content-Disposition: %v", r.Header.Get("Content-Disposition"))
+		}
+		if r.ContentLength != 4 {
+			t.Errorf("Unexpected length: %v", r.ContentLength)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":9}`))
+	})
+
+	media, _, _, err := wp.Media().Create(&wordpress.MediaUploadOptions{Filename: "my photo.jpg", Data: []byte("data")})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if media.ID != 9 {
+		t.Errorf("Unexpected media: %+v", media)
+	}
+}
+
+func TestMediaCreate_NonASCIIFilename(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") == "image/jpeg" {
+			expected := `attachment; filename="café.jpg"; filename*=UTF-8''caf%C3%A9.jpg`
+			if r.Header.Get("Content-Disposition") != expected {
+				t.Errorf("Unexpected Content-Disposition: %v", r.Header.Get("Content-Disposition"))
+			}
+		} else {
+			_, header, err := r.FormFile("file")
+			if err != nil || header.Filename != "café.jpg" {
+				t.Errorf("Unexpected file part: %v, %v", header, err)
+			}
+			if !strings.Contains(header.Header.Get("Content-Disposition"), `filename="café.jpg"`) {
+				t.Errorf("Unexpected Content-Disposition: %v", header.Header.Get("Content-Disposition"))
+			}
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":5}`))
+	})
+
+	for _, multipart := range []bool{false, true} {
+		_, _, _, err := wp.Media().Create(&wordpress.MediaUploadOptions{
+			Filename:  "café.jpg",
+			Data:      []byte("jpeg"),
+			Multipart: multipart,
+		})
+		if err != nil {
+			t.Errorf("Should not return error: %v", err.Error())
+		}
+	}
+}
+
+func TestMediaCreate_StreamUnknownLength(t *testing.T) {
+	content := append(append([]byte{}, pngHeader...), bytes.Repeat([]byte("x"), 100000)...)
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "image/png" {
+			t.Errorf("Content type should be sniffed: %v", r.Header.Get("Content-Type"))
+		}
+		if r.ContentLength != -1 {
+			t.Errorf("Unknown length should be sent chunked: %v", r.ContentLength)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if !bytes.Equal(body, content) {
+			t.Errorf("Unexpected body of %v bytes", len(body))
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":9}`))
+	})
+
+	var mu sync.Mutex
+	var sent, total int64
+	_, _, _, err := wp.Media().Create(&wordpress.MediaUploadOptions{
+		Filename: "upload",
+		Reader:   onlyReader{bytes.NewReader(content)},
+		Progress: func(s int64, t int64) {
+			mu.Lock()
+			defer mu.Unlock()
+			sent, total = s, t
+		},
+	})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if sent != int64(len(content)) || total != -1 {
+		t.Errorf("Unexpected progress: %v of %v", sent, total)
+	}
+}
+
+func TestMediaCreate_StreamFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "clip.mp4")
+	os.WriteFile(path, bytes.Repeat([]byte("v"), 2048), 0o600)
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength != 2048 {
+			t.Errorf("File length should be taken from Stat: %v", r.ContentLength)
+		}
+		if r.Header.Get("Content-Type") != "video/mp4" {
+			t.Errorf("Unexpected content type: %v", r.Header.Get("Content-Type"))
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":9}`))
+	})
+
+	if _, _, _, err := wp.Media().Create(&wordpress.MediaUploadOptions{Filename: "clip.mp4", Reader: file}); err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+}
+
+func TestMediaCreate_Multipart(t *testing.T) {
+	wp := initTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.ContentLength != int64(len(body)) {
+			t.Errorf("Content length %v should match the body length %v", r.ContentLength, len(body))
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Should send multipart form: %v", err)
+		}
+		for field, expected := range map[string]string{"title": "Cover", "alt_text": "A cover", "caption": "Caption", "post": "12"} {
+			if r.FormValue(field) != expected {
+				t.Errorf("Field %v: expected %v, got %v", field, expected, r.FormValue(field))
+			}
+		}
+		if _, ok := r.MultipartForm.Value["description"]; ok {
+			t.Errorf("Empty fields should not be sent")
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Should send file part: %v", err)
+		}
+		data, _ := io.ReadAll(file)
+		if header.Filename != "cover.png" || header.Header.Get("Content-Type") != "image/png" || !bytes.Equal(data, pngHeader) {
+			t.Errorf("Unexpected file part: %v, %v", header.Filename, header.Header)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":9,"post":12}`))
+	})
+
+	var sent int64
+	media, _, _, err := wp.Media().Create(&wordpress.MediaUploadOptions{
+		Filename: "cover.png",
+		Reader:   strings.NewReader(string(pngHeader)),
+		Size:     int64(len(pngHeader)),
+		Title:    "Cover",
+		AltText:  "A cover",
+		Caption:  "Caption",
+		Post:     12,
+		Progress: func(s int64, total int64) { sent = s },
+	})
+	if err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if media.Post != 12 || sent != int64(len(pngHeader)) {
+		t.Errorf("Unexpected media %+v or progress %v", media, sent)
+	}
+}
+
+func TestMediaCreate_RetryData(t *testing.T) {
+	attempts := 0
+	wp := initTestRetryClient(t, &wordpress.RetryPolicy{MaxAttempts: 2, RetryMutations: true}, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		r.ParseMultipartForm(1 << 20)
+		if r.FormValue("title") != "Retried" {
+			t.Errorf("Attempt %v should send the whole form", attempts)
+		}
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":9}`))
+	})
+
+	if _, _, _, err := wp.Media().Create(&wordpress.MediaUploadOptions{Filename: "a.txt", Data: []byte("text"), Title: "Retried"}); err != nil {
+		t.Fatalf("Should not return error: %v", err.Error())
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %v", attempts)
+	}
+}
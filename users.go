@@ -1,6 +1,7 @@
 package wordpress
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -33,9 +34,34 @@ type User struct {
 	Password          string                 `json:"password,omitempty"`
 }
 
+// UserListParams are the query parameters of UsersCollection.List.
+type UserListParams struct {
+	ListParams
+
+	Slug              []string `url:"slug,omitempty"`
+	Roles             []string `url:"roles,omitempty"`
+	Capabilities      []string `url:"capabilities,omitempty"`
+	Who               string   `url:"who,omitempty"`
+	HasPublishedPosts *bool    `url:"has_published_posts,omitempty"`
+}
+
+// UserDeleteParams are the query parameters of UsersCollection.Delete.
+// Users cannot be trashed, so Force must be set.
+type UserDeleteParams struct {
+	DeleteParams
+
+	// Reassign is the user ID to reassign the deleted user's posts and links to.
+	Reassign int `url:"reassign,omitempty"`
+}
+
 type UsersCollection struct {
-	client *Client
-	url    string
+	*Collection[User]
+}
+
+func newUsersCollection(client *Client, url string) *UsersCollection {
+	col := &UsersCollection{}
+	col.Collection = newCollection(client, url, func(entity *User) { entity.setCollection(col) })
+	return col
 }
 
 func (entity *User) setCollection(col *UsersCollection) {
@@ -44,7 +70,6 @@ func (entity *User) setCollection(col *UsersCollection) {
 func (entity *User) Meta() *MetaCollection {
 	if entity.collection == nil {
 		// missing user.collection parent. Probably User struct was initialized manually.
-		_warning("Missing parent post collection")
 		return nil
 	}
 	return &MetaCollection{
@@ -54,57 +79,24 @@ func (entity *User) Meta() *MetaCollection {
 		url:        fmt.Sprintf("%v/%v/%v", entity.collection.url, entity.ID, CollectionMeta),
 	}
 }
+func (entity *User) ApplicationPasswords() *ApplicationPasswordsCollection {
+	if entity.collection == nil {
+		// missing user.collection parent. Probably User struct was initialized manually.
+		return nil
+	}
+	return &ApplicationPasswordsCollection{
+		client: entity.collection.client,
+		parent: entity,
+		url:    fmt.Sprintf("%v/%v/%v", entity.collection.url, entity.ID, CollectionApplicationPasswords),
+	}
+}
 func (col *UsersCollection) Me(params interface{}) (*User, *http.Response, []byte, error) {
+	return col.MeContext(context.Background(), params)
+}
+func (col *UsersCollection) MeContext(ctx context.Context, params interface{}) (*User, *http.Response, []byte, error) {
 	url := fmt.Sprintf("%v/me", col.url)
 	var user User
-	resp, body, err := col.client.Get(url, params, &user)
+	resp, body, err := col.client.GetContext(ctx, url, params, &user)
+	col.setCollection(&user)
 	return &user, resp, body, err
 }
-func (col *UsersCollection) List(params interface{}) ([]User, *http.Response, []byte, error) {
-	var users []User
-	resp, body, err := col.client.List(col.url, params, &users)
-	// set collection object for each entity which has sub-collection
-	for i := range users {
-		users[i].setCollection(col)
-	}
-
-	return users, resp, body, err
-}
-func (col *UsersCollection) Create(new *User) (*User, *http.Response, []byte, error) {
-	var created User
-	resp, body, err := col.client.Create(col.url, new, &created)
-
-	created.setCollection(col)
-
-	return &created, resp, body, err
-}
-func (col *UsersCollection) Get(id int, params interface{}) (*User, *http.Response, []byte, error) {
-	var entity User
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Get(entityURL, params, &entity)
-
-	// set collection object for each entity which has sub-collection
-	entity.setCollection(col)
-
-	return &entity, resp, body, err
-}
-func (col *UsersCollection) Update(id int, post *User) (*User, *http.Response, []byte, error) {
-	var updated User
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Update(entityURL, post, &updated)
-
-	// set collection object for each entity which has sub-collection
-	updated.setCollection(col)
-
-	return &updated, resp, body, err
-}
-func (col *UsersCollection) Delete(id int, params interface{}) (*User, *http.Response, []byte, error) {
-	var deleted User
-	entityURL := fmt.Sprintf("%v/%v", col.url, id)
-	resp, body, err := col.client.Delete(entityURL, params, &deleted)
-
-	// set collection object for each entity which has sub-collection
-	deleted.setCollection(col)
-
-	return &deleted, resp, body, err
-}
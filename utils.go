@@ -1,61 +1,47 @@
 package wordpress
 
 import (
-	"bytes"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"log"
 	"net/http"
-	"os"
+	"net/url"
+	"strings"
 )
 
-var DEBUG bool = (os.Getenv("DEBUG") == "1")
-
 func unmarshallResponse(resp *http.Response, body []byte, result interface{}) error {
-
-	var prettyJSON bytes.Buffer
-	err2 := json.Indent(&prettyJSON, body, "", "  ")
-	if err2 != nil {
-		log.Println("JSON parse error: ", err2)
-
-		if DEBUG {
-			log.Println("body: ", string(body))
-		}
-	}
-	if DEBUG {
-		log.Println("body: ", string(prettyJSON.Bytes()))
-	}
-
 	if resp.StatusCode != http.StatusOK &&
 		resp.StatusCode != http.StatusCreated &&
 		resp.StatusCode != http.StatusAccepted {
-		return errors.New(resp.Status)
+		return newAPIError(resp, body)
 	}
 
 	err := json.Unmarshal(body, result)
 	if err != nil {
-		log.Println("JSON parse error: ", err)
-		return err
+		return fmt.Errorf("wordpress: error decoding response: %w", err)
 	}
 	return nil
 }
 
-func _warning(v ...interface{}) {
-	log.Println(fmt.Sprintln("[wordpress]", v))
-}
-
-func _log(v ...interface{}) {
-	log.Println(fmt.Sprintln("[wordpress]", v))
-}
-
-// UnmarshallServerError A helper function to unmarshall error response from server
+// UnmarshallServerError A helper function to unmarshall error response from server.
+// Accepts both a single error object and a list of errors.
 func UnmarshallServerError(body []byte) ([]GeneralError, error) {
 	var resp []GeneralError
 	err := json.Unmarshal(body, &resp)
-	if err != nil {
-		log.Println("JSON parse error: ", err)
-		return nil, err
+	if err == nil {
+		return resp, nil
+	}
+	var single GeneralError
+	if err2 := json.Unmarshal(body, &single); err2 == nil {
+		return []GeneralError{single}, nil
+	}
+	return nil, err
+}
+
+// escapePath escapes each segment of a path, keeping its slashes.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
 	}
-	return resp, nil
+	return strings.Join(segments, "/")
 }